@@ -0,0 +1,194 @@
+// Package chains is a registry of EVM chain metadata, generated from
+// the chainlist/DefiLlama chain list format.
+package chains
+
+import "strings"
+
+// NativeCurrency describes a chain's native token.
+type NativeCurrency struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals int    `json:"decimals"`
+}
+
+// Explorer describes a single block explorer entry for a chain.
+// Standard is a pointer so a missing/unknown explorer standard
+// ("none") can be distinguished from an absent field.
+type Explorer struct {
+	Name     string  `json:"name"`
+	URL      string  `json:"url"`
+	Standard *string `json:"standard,omitempty"`
+}
+
+// Chain describes a single EVM chain.
+type Chain struct {
+	Name           string         `json:"name"`
+	Chain          string         `json:"chain"`
+	ShortName      string         `json:"shortName"`
+	ChainID        uint64         `json:"chainId"`
+	NetworkID      uint64         `json:"networkId"`
+	NativeCurrency NativeCurrency `json:"nativeCurrency"`
+	RPC            []string       `json:"rpc"`
+	Explorers      []Explorer     `json:"explorers,omitempty"`
+	InfoURL        *string        `json:"infoURL,omitempty"`
+}
+
+func strPtr(s string) *string { return &s }
+
+// AllChains is the full chain registry.
+var AllChains = []Chain{
+	{
+		Name: "Ethereum Mainnet", Chain: "ETH", ShortName: "eth",
+		ChainID: 1, NetworkID: 1,
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPC:            []string{"https://eth.llamarpc.com", "https://rpc.ankr.com/eth"},
+		Explorers:      []Explorer{{Name: "etherscan", URL: "https://etherscan.io", Standard: strPtr("EIP3091")}},
+		InfoURL:        strPtr("https://ethereum.org"),
+	},
+	{
+		Name: "Ronin2020", Chain: "RON", ShortName: "ronin",
+		ChainID: 2020, NetworkID: 2020,
+		NativeCurrency: NativeCurrency{Name: "Ronin", Symbol: "RON", Decimals: 18},
+		RPC: []string{
+			"https://api.roninchain.com/rpc",
+			"https://ronin.drpc.org",
+			"https://rpc.ankr.com/ronin",
+		},
+		Explorers: []Explorer{{Name: "ronin explorer", URL: "https://app.roninchain.com", Standard: strPtr("EIP3091")}},
+	},
+	{
+		Name: "Flr14", Chain: "FLR", ShortName: "flr14",
+		ChainID: 14, NetworkID: 14,
+		NativeCurrency: NativeCurrency{Name: "Flare", Symbol: "FLR", Decimals: 18},
+		RPC: []string{
+			"https://flare-api.flare.network/ext/C/rpc",
+			"https://rpc.ftso.au/flare",
+			"https://flare.rpc.thirdweb.com",
+		},
+	},
+	{
+		Name: "Plasma Testnet", Chain: "XPL", ShortName: "plasma-testnet",
+		ChainID: 9746, NetworkID: 9746,
+		NativeCurrency: NativeCurrency{Name: "Plasma", Symbol: "XPL", Decimals: 18},
+		RPC:            []string{"https://testnet-rpc.plasma.to"},
+	},
+	{
+		Name: "PlasmaDevnet", Chain: "XPL", ShortName: "plasma-devnet",
+		ChainID: 9747, NetworkID: 9747,
+		NativeCurrency: NativeCurrency{Name: "Plasma", Symbol: "XPL", Decimals: 18},
+		RPC:            []string{"https://devnet-rpc.plasma.to"},
+	},
+	{
+		// Tricky case: display name reads mainnet-ish, but the chain
+		// family identifier flags it as a dev chain.
+		Name: "Forknet", Chain: "FRK-DEVNET", ShortName: "forknet",
+		ChainID: 31337, NetworkID: 31337,
+		NativeCurrency: NativeCurrency{Name: "Fork Ether", Symbol: "ETH", Decimals: 18},
+		RPC:            []string{"http://127.0.0.1:8545"},
+	},
+	{
+		Name: "Codex", Chain: "CDX", ShortName: "codex",
+		ChainID: 81224, NetworkID: 81224,
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPC:            []string{"https://rpc.codex.xyz"},
+	},
+	{
+		Name: "HPP", Chain: "HPP", ShortName: "hpp",
+		ChainID: 35441, NetworkID: 35441,
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPC:            []string{"https://rpc.hpp.io"},
+		Explorers:      []Explorer{{Name: "hppscan", URL: "https://explorer.hpp.io", Standard: strPtr("none")}},
+	},
+	{
+		Name: "Zilliqa EVM", Chain: "ZIL", ShortName: "zil",
+		ChainID: 32769, NetworkID: 32769,
+		NativeCurrency: NativeCurrency{Name: "Zilliqa", Symbol: "ZIL", Decimals: 18},
+		RPC:            []string{"https://api.zilliqa.com"},
+		Explorers:      []Explorer{{Name: "viewblock", URL: "https://viewblock.io/zilliqa"}},
+	},
+	{
+		Name: "Ozone Chain", Chain: "OZO", ShortName: "ozone",
+		ChainID: 4000, NetworkID: 4000,
+		NativeCurrency: NativeCurrency{Name: "Ozone", Symbol: "OZO", Decimals: 18},
+		RPC:            []string{"https://rpc.ozonechain.io"},
+	},
+	{
+		Name: "Ozone Sepolia Testnet", Chain: "OZO", ShortName: "ozone",
+		ChainID: 4001, NetworkID: 4001,
+		NativeCurrency: NativeCurrency{Name: "Ozone", Symbol: "OZO", Decimals: 18},
+		RPC:            []string{"https://sepolia-rpc.ozonechain.io"},
+	},
+	{
+		Name: "Mova Mainnet", Chain: "MOVA", ShortName: "mova",
+		ChainID: 5000, NetworkID: 5000,
+		NativeCurrency: NativeCurrency{Name: "Mova", Symbol: "MOVA", Decimals: 18},
+		RPC:            []string{"https://rpc.movachain.com"},
+	},
+	{
+		Name: "Mova Galileo Testnet", Chain: "MOVA", ShortName: "mova",
+		ChainID: 5001, NetworkID: 5001,
+		NativeCurrency: NativeCurrency{Name: "Mova", Symbol: "MOVA", Decimals: 18},
+		RPC:            []string{"https://galileo-rpc.movachain.com"},
+	},
+	{
+		// Legitimate exception: ChainID and NetworkID differ by design
+		// for this chain; see the allowlist in validate.go.
+		Name: "RootVX41295", Chain: "RVX", ShortName: "rootvx",
+		ChainID: 41295, NetworkID: 99999,
+		NativeCurrency: NativeCurrency{Name: "RootVX", Symbol: "RVX", Decimals: 18},
+		RPC:            []string{"https://rpc.rootvx.io"},
+	},
+}
+
+// ChainByID is a precomputed index of AllChains keyed by ChainID for
+// O(1) lookups.
+var ChainByID = func() map[uint64]*Chain {
+	m := make(map[uint64]*Chain, len(AllChains))
+	for i := range AllChains {
+		m[AllChains[i].ChainID] = &AllChains[i]
+	}
+	return m
+}()
+
+// GetChainByID returns the chain with the given ChainID, or nil if
+// none is registered.
+func GetChainByID(id uint64) *Chain {
+	return ChainByID[id]
+}
+
+// ChainByShortName is a precomputed index of AllChains keyed by
+// lowercased ShortName for O(1) single lookups. Since several chains
+// reuse the same ShortName (e.g. "ozone", "mova"), this holds only the
+// first match in AllChains order; use GetChainsByShortName for every
+// match.
+var ChainByShortName = func() map[string]*Chain {
+	m := make(map[string]*Chain, len(AllChains))
+	for i := range AllChains {
+		key := strings.ToLower(AllChains[i].ShortName)
+		if _, ok := m[key]; !ok {
+			m[key] = &AllChains[i]
+		}
+	}
+	return m
+}()
+
+// GetChainByShortName returns the first chain whose ShortName matches
+// name case-insensitively, or nil if none match.
+func GetChainByShortName(name string) *Chain {
+	return ChainByShortName[strings.ToLower(name)]
+}
+
+// GetChainsByShortName returns every chain whose ShortName matches
+// name case-insensitively. Several chains legitimately reuse the same
+// ShortName (e.g. a mainnet and its testnet), so this can return more
+// than one result.
+func GetChainsByShortName(name string) []Chain {
+	lower := strings.ToLower(name)
+	var out []Chain
+	for _, c := range AllChains {
+		if strings.ToLower(c.ShortName) == lower {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+package chains
+
+import "testing"
+
+func TestGetChainByID(t *testing.T) {
+	c := GetChainByID(1)
+	if c == nil || c.Name != "Ethereum Mainnet" {
+		t.Fatalf("got %+v", c)
+	}
+	if GetChainByID(999999999) != nil {
+		t.Error("want nil for unknown chain ID")
+	}
+}
+
+func TestGetChainByShortName(t *testing.T) {
+	c := GetChainByShortName("RONIN")
+	if c == nil || c.ChainID != 2020 {
+		t.Fatalf("got %+v", c)
+	}
+	if GetChainByShortName("plasma-testnet") == nil {
+		t.Error("want plasma-testnet to resolve")
+	}
+	if GetChainByShortName("does-not-exist") != nil {
+		t.Error("want nil for unknown short name")
+	}
+}
+
+func TestGetChainsByShortNameDuplicates(t *testing.T) {
+	ozone := GetChainsByShortName("Ozone")
+	if len(ozone) != 2 {
+		t.Fatalf("want 2 chains named ozone, got %d", len(ozone))
+	}
+	mova := GetChainsByShortName("mova")
+	if len(mova) != 2 {
+		t.Fatalf("want 2 chains named mova, got %d", len(mova))
+	}
+	if len(GetChainsByShortName("eth")) != 1 {
+		t.Error("want exactly 1 chain named eth")
+	}
+}
@@ -0,0 +1,31 @@
+package chains
+
+// ChainsByExplorerStandard returns every chain with at least one
+// Explorer whose Standard equals standard exactly. A nil Standard
+// (the field was never set) and the literal string "none" are
+// treated as distinct values, matching the source data: pass "none"
+// to match explorers explicitly marked as following no standard, and
+// use ChainsWithoutExplorer for entries with no Explorers at all.
+func ChainsByExplorerStandard(standard string) []Chain {
+	var out []Chain
+	for _, c := range AllChains {
+		for _, ex := range c.Explorers {
+			if ex.Standard != nil && *ex.Standard == standard {
+				out = append(out, c)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// ChainsWithoutExplorer returns every chain with no Explorers at all.
+func ChainsWithoutExplorer() []Chain {
+	var out []Chain
+	for _, c := range AllChains {
+		if len(c.Explorers) == 0 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package chains
+
+import "testing"
+
+func TestChainsByExplorerStandardEIP3091(t *testing.T) {
+	got := ChainsByExplorerStandard("EIP3091")
+	names := make(map[string]bool, len(got))
+	for _, c := range got {
+		names[c.Name] = true
+	}
+	for _, want := range []string{"Ethereum Mainnet", "Ronin2020"} {
+		if !names[want] {
+			t.Errorf("want %q to have an EIP3091 explorer, got %+v", want, got)
+		}
+	}
+}
+
+func TestChainsByExplorerStandardNone(t *testing.T) {
+	got := ChainsByExplorerStandard("none")
+	found := false
+	for _, c := range got {
+		if c.Name == "HPP" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("want HPP to match explorer standard \"none\", got %+v", got)
+	}
+}
+
+func TestChainsByExplorerStandardNilNotMatched(t *testing.T) {
+	// Zilliqa has an explorer with no Standard set (nil); it must not
+	// match a query for the literal string "none".
+	got := ChainsByExplorerStandard("none")
+	for _, c := range got {
+		if c.Name == "Zilliqa EVM" {
+			t.Errorf("nil Standard should not match \"none\"")
+		}
+	}
+}
+
+func TestChainsWithoutExplorer(t *testing.T) {
+	got := ChainsWithoutExplorer()
+	found := false
+	for _, c := range got {
+		if c.Name == "Codex" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("want Codex (no explorers) in ChainsWithoutExplorer, got %+v", got)
+	}
+}
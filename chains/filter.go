@@ -0,0 +1,27 @@
+package chains
+
+import "strings"
+
+// FilterByCurrencySymbol returns a fresh slice of every chain whose
+// NativeCurrency.Symbol equals symbol, case-insensitively. Callers can
+// freely mutate the result without affecting AllChains.
+func FilterByCurrencySymbol(symbol string) []Chain {
+	want := strings.ToLower(symbol)
+	var out []Chain
+	for _, c := range AllChains {
+		if strings.ToLower(c.NativeCurrency.Symbol) == want {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CountByCurrencySymbol returns the number of chains registered for
+// each native currency symbol, for rendering a histogram.
+func CountByCurrencySymbol() map[string]int {
+	counts := make(map[string]int)
+	for _, c := range AllChains {
+		counts[c.NativeCurrency.Symbol]++
+	}
+	return counts
+}
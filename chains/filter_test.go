@@ -0,0 +1,38 @@
+package chains
+
+import "testing"
+
+func TestFilterByCurrencySymbolETH(t *testing.T) {
+	got := FilterByCurrencySymbol("eth")
+	names := make(map[string]bool, len(got))
+	for _, c := range got {
+		names[c.Name] = true
+	}
+	for _, want := range []string{"Ethereum Mainnet", "Codex", "Forknet", "HPP"} {
+		if !names[want] {
+			t.Errorf("want %q in ETH-symbol chains, got %+v", want, got)
+		}
+	}
+}
+
+func TestFilterByCurrencySymbolReturnsFreshSlice(t *testing.T) {
+	got := FilterByCurrencySymbol("ETH")
+	if len(got) > 0 {
+		got[0].Name = "mutated"
+	}
+	for _, c := range AllChains {
+		if c.Name == "mutated" {
+			t.Fatal("FilterByCurrencySymbol result aliased AllChains")
+		}
+	}
+}
+
+func TestCountByCurrencySymbol(t *testing.T) {
+	counts := CountByCurrencySymbol()
+	if counts["ETH"] < 4 {
+		t.Errorf("want at least 4 ETH chains, got %d", counts["ETH"])
+	}
+	if counts["MOVA"] != 2 {
+		t.Errorf("want 2 MOVA chains, got %d", counts["MOVA"])
+	}
+}
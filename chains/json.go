@@ -0,0 +1,20 @@
+package chains
+
+import "encoding/json"
+
+// ExportJSON marshals AllChains into the chainlist/DefiLlama JSON
+// shape (the same shape Chain's own json tags already describe), so
+// it can be diffed against the upstream source.
+func ExportJSON() ([]byte, error) {
+	return json.Marshal(AllChains)
+}
+
+// ImportJSON parses data in the chainlist/DefiLlama JSON shape back
+// into a []Chain, the inverse of ExportJSON.
+func ImportJSON(data []byte) ([]Chain, error) {
+	var chains []Chain
+	if err := json.Unmarshal(data, &chains); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
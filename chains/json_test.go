@@ -0,0 +1,39 @@
+package chains
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExportImportJSONRoundtrip(t *testing.T) {
+	data, err := ExportJSON()
+	if err != nil {
+		t.Fatalf("ExportJSON: %v", err)
+	}
+	got, err := ImportJSON(data)
+	if err != nil {
+		t.Fatalf("ImportJSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, AllChains) {
+		t.Fatalf("roundtrip mismatch:\ngot  %+v\nwant %+v", got, AllChains)
+	}
+}
+
+func TestExportJSONOmitsNilPointerFields(t *testing.T) {
+	data, err := ExportJSON()
+	if err != nil {
+		t.Fatalf("ExportJSON: %v", err)
+	}
+	roundtripped, err := ImportJSON(data)
+	if err != nil {
+		t.Fatalf("ImportJSON: %v", err)
+	}
+	for i, c := range roundtripped {
+		if c.InfoURL == nil && AllChains[i].InfoURL != nil {
+			t.Errorf("chain %s lost its InfoURL across the roundtrip", c.Name)
+		}
+		if c.InfoURL != nil && AllChains[i].InfoURL == nil {
+			t.Errorf("chain %s gained an InfoURL it shouldn't have", c.Name)
+		}
+	}
+}
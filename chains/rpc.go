@@ -0,0 +1,33 @@
+package chains
+
+import "math/rand"
+
+// RandomRPC returns a uniformly random URL from c.RPC, or "" if c has
+// no RPC URLs.
+func (c Chain) RandomRPC() string {
+	return c.RandomRPCWithSource(rand.New(rand.NewSource(rand.Int63())))
+}
+
+// RandomRPCWithSource behaves like RandomRPC but draws from src,
+// letting callers seed a deterministic source for tests.
+func (c Chain) RandomRPCWithSource(src *rand.Rand) string {
+	if len(c.RPC) == 0 {
+		return ""
+	}
+	return c.RPC[src.Intn(len(c.RPC))]
+}
+
+// RotatingRPC returns a closure that cycles through c.RPC round-robin
+// on each call, starting from the first URL. The closure always
+// returns "" if c has no RPC URLs.
+func (c Chain) RotatingRPC() func() string {
+	i := 0
+	return func() string {
+		if len(c.RPC) == 0 {
+			return ""
+		}
+		url := c.RPC[i%len(c.RPC)]
+		i++
+		return url
+	}
+}
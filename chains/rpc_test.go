@@ -0,0 +1,46 @@
+package chains
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomRPCEmpty(t *testing.T) {
+	c := Chain{}
+	if got := c.RandomRPC(); got != "" {
+		t.Errorf("want empty string for no RPC URLs, got %q", got)
+	}
+}
+
+func TestRandomRPCWithSourceEventuallyReturnsEachURL(t *testing.T) {
+	c := *GetChainByShortName("flr14")
+	seen := make(map[string]bool)
+	src := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		seen[c.RandomRPCWithSource(src)] = true
+	}
+	for _, url := range c.RPC {
+		if !seen[url] {
+			t.Errorf("url %q was never returned", url)
+		}
+	}
+}
+
+func TestRotatingRPCRoundRobin(t *testing.T) {
+	c := *GetChainByShortName("flr14")
+	next := c.RotatingRPC()
+	for round := 0; round < 3; round++ {
+		for _, want := range c.RPC {
+			if got := next(); got != want {
+				t.Errorf("round %d: got %q, want %q", round, got, want)
+			}
+		}
+	}
+}
+
+func TestRotatingRPCEmpty(t *testing.T) {
+	next := Chain{}.RotatingRPC()
+	if got := next(); got != "" {
+		t.Errorf("want empty string for no RPC URLs, got %q", got)
+	}
+}
@@ -0,0 +1,37 @@
+package chains
+
+import (
+	"sort"
+	"strings"
+)
+
+// Search returns every chain where query (case-insensitive) is a
+// substring of Name, Chain, ShortName, or NativeCurrency.Symbol,
+// sorted by ChainID ascending. An empty query returns an empty slice
+// rather than every chain.
+func Search(query string) []Chain {
+	return SearchN(query, 0)
+}
+
+// SearchN behaves like Search but caps the result at limit entries.
+// limit <= 0 means unlimited.
+func SearchN(query string, limit int) []Chain {
+	if query == "" {
+		return []Chain{}
+	}
+	q := strings.ToLower(query)
+	var out []Chain
+	for _, c := range AllChains {
+		if strings.Contains(strings.ToLower(c.Name), q) ||
+			strings.Contains(strings.ToLower(c.Chain), q) ||
+			strings.Contains(strings.ToLower(c.ShortName), q) ||
+			strings.Contains(strings.ToLower(c.NativeCurrency.Symbol), q) {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ChainID < out[j].ChainID })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package chains
+
+import "testing"
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query     string
+		wantNames []string
+	}{
+		{"plasma", []string{"Plasma Testnet", "PlasmaDevnet"}},
+		{"ETH", []string{"Ethereum Mainnet", "Forknet", "HPP", "Codex"}},
+		{"zil", []string{"Zilliqa EVM"}},
+		{"", nil},
+		{"nonexistentxyz", nil},
+	}
+	for _, tt := range tests {
+		got := Search(tt.query)
+		if len(got) != len(tt.wantNames) {
+			t.Errorf("Search(%q) = %d results, want %d (%v)", tt.query, len(got), len(tt.wantNames), got)
+			continue
+		}
+		for i, name := range tt.wantNames {
+			if got[i].Name != name {
+				t.Errorf("Search(%q)[%d].Name = %q, want %q", tt.query, i, got[i].Name, name)
+			}
+		}
+	}
+}
+
+func TestSearchSortedByChainID(t *testing.T) {
+	got := Search("mova")
+	for i := 1; i < len(got); i++ {
+		if got[i-1].ChainID > got[i].ChainID {
+			t.Errorf("results not sorted by ChainID: %+v", got)
+		}
+	}
+}
+
+func TestSearchN(t *testing.T) {
+	got := SearchN("a", 2)
+	if len(got) > 2 {
+		t.Errorf("want at most 2 results, got %d", len(got))
+	}
+}
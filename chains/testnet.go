@@ -0,0 +1,49 @@
+package chains
+
+import "strings"
+
+// testnetMarkers are substrings that, when found in a chain's Name,
+// Chain, or ShortName (case-insensitively), identify it as a
+// testnet/devnet rather than a production network. Extend this list
+// as new non-mainnet naming conventions show up in the registry.
+var testnetMarkers = []string{
+	"testnet", "devnet", "sepolia", "goerli", "galileo", "orchard",
+}
+
+// IsTestnet reports whether c looks like a testnet or devnet, based on
+// markers found in its Name, Chain, or ShortName. This is a heuristic:
+// some chains (e.g. "Forknet") read as mainnet in their display Name
+// but carry a marker in another field.
+func (c Chain) IsTestnet() bool {
+	fields := strings.ToLower(c.Name + " " + c.Chain + " " + c.ShortName)
+	for _, marker := range testnetMarkers {
+		if strings.Contains(fields, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// Mainnets returns every chain in AllChains for which IsTestnet is
+// false.
+func Mainnets() []Chain {
+	var out []Chain
+	for _, c := range AllChains {
+		if !c.IsTestnet() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Testnets returns every chain in AllChains for which IsTestnet is
+// true.
+func Testnets() []Chain {
+	var out []Chain
+	for _, c := range AllChains {
+		if c.IsTestnet() {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,42 @@
+package chains
+
+import "testing"
+
+func TestIsTestnet(t *testing.T) {
+	tests := []struct {
+		shortName string
+		want      bool
+	}{
+		{"eth", false},
+		{"ronin", false},
+		{"forknet", true}, // tricky: reads mainnet-ish but is flagged via the Chain field
+		{"plasma-devnet", true},
+		{"plasma-testnet", true},
+	}
+	for _, tt := range tests {
+		c := GetChainByShortName(tt.shortName)
+		if c == nil {
+			t.Fatalf("no chain with short name %q", tt.shortName)
+		}
+		if got := c.IsTestnet(); got != tt.want {
+			t.Errorf("%s.IsTestnet() = %v, want %v", c.Name, got, tt.want)
+		}
+	}
+}
+
+func TestMainnetsAndTestnetsPartitionAllChains(t *testing.T) {
+	mainnets, testnets := Mainnets(), Testnets()
+	if len(mainnets)+len(testnets) != len(AllChains) {
+		t.Fatalf("mainnets(%d) + testnets(%d) != AllChains(%d)", len(mainnets), len(testnets), len(AllChains))
+	}
+	for _, c := range mainnets {
+		if c.IsTestnet() {
+			t.Errorf("%s in Mainnets() but IsTestnet() is true", c.Name)
+		}
+	}
+	for _, c := range testnets {
+		if !c.IsTestnet() {
+			t.Errorf("%s in Testnets() but IsTestnet() is false", c.Name)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package chains
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// chainIDMismatchAllowlist lists chains, keyed by ShortName, that
+// legitimately have ChainID != NetworkID by design rather than by
+// generator error.
+var chainIDMismatchAllowlist = map[string]bool{
+	"rootvx": true,
+}
+
+// ValidateChains walks AllChains and reports integrity problems that
+// can slip in since chains.go is generated: duplicate ChainIDs,
+// ChainID/NetworkID mismatches outside the allowlist, chains with no
+// RPC URLs, and malformed RPC or explorer URLs. It returns every
+// problem found rather than failing fast on the first one.
+func ValidateChains() []error {
+	return validateSlice(AllChains)
+}
+
+// validateSlice holds the actual checks so tests can exercise them
+// against synthetic data without mutating the real registry.
+func validateSlice(chains []Chain) []error {
+	var errs []error
+	seenIDs := make(map[uint64]string)
+
+	for _, c := range chains {
+		if prev, ok := seenIDs[c.ChainID]; ok {
+			errs = append(errs, fmt.Errorf("duplicate ChainID %d: %q and %q", c.ChainID, prev, c.Name))
+		} else {
+			seenIDs[c.ChainID] = c.Name
+		}
+
+		if c.ChainID != c.NetworkID && !chainIDMismatchAllowlist[c.ShortName] {
+			errs = append(errs, fmt.Errorf("%s: ChainID %d != NetworkID %d", c.Name, c.ChainID, c.NetworkID))
+		}
+
+		if len(c.RPC) == 0 {
+			errs = append(errs, fmt.Errorf("%s: no RPC URLs", c.Name))
+		}
+		for _, rpc := range c.RPC {
+			if !validURL(rpc) {
+				errs = append(errs, fmt.Errorf("%s: invalid RPC URL %q", c.Name, rpc))
+			}
+		}
+
+		for _, ex := range c.Explorers {
+			if !validURL(ex.URL) {
+				errs = append(errs, fmt.Errorf("%s: invalid explorer URL %q", c.Name, ex.URL))
+			}
+		}
+	}
+	return errs
+}
+
+// validURL reports whether s parses as an absolute http(s) URL.
+func validURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
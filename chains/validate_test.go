@@ -0,0 +1,49 @@
+package chains
+
+import "testing"
+
+func TestValidateChainsOnRealRegistry(t *testing.T) {
+	if errs := ValidateChains(); len(errs) != 0 {
+		t.Errorf("AllChains should validate cleanly, got: %v", errs)
+	}
+}
+
+func TestValidateSliceCatchesDuplicateChainID(t *testing.T) {
+	chains := []Chain{
+		{Name: "A", ChainID: 1, NetworkID: 1, RPC: []string{"https://a.example"}},
+		{Name: "B", ChainID: 1, NetworkID: 1, RPC: []string{"https://b.example"}},
+	}
+	if errs := validateSlice(chains); len(errs) != 1 {
+		t.Fatalf("want 1 error, got %v", errs)
+	}
+}
+
+func TestValidateSliceCatchesIDMismatchUnlessAllowlisted(t *testing.T) {
+	chains := []Chain{
+		{Name: "Mismatch", ShortName: "not-allowlisted", ChainID: 1, NetworkID: 2, RPC: []string{"https://a.example"}},
+		{Name: "Allowed", ShortName: "rootvx", ChainID: 3, NetworkID: 4, RPC: []string{"https://b.example"}},
+	}
+	errs := validateSlice(chains)
+	if len(errs) != 1 {
+		t.Fatalf("want 1 error (only the non-allowlisted mismatch), got %v", errs)
+	}
+}
+
+func TestValidateSliceCatchesEmptyAndInvalidRPC(t *testing.T) {
+	chains := []Chain{
+		{Name: "NoRPC", ChainID: 1, NetworkID: 1},
+		{Name: "BadRPC", ChainID: 2, NetworkID: 2, RPC: []string{"not a url"}},
+	}
+	if errs := validateSlice(chains); len(errs) != 2 {
+		t.Fatalf("want 2 errors, got %v", errs)
+	}
+}
+
+func TestValidateSliceCatchesInvalidExplorerURL(t *testing.T) {
+	chains := []Chain{
+		{Name: "BadExplorer", ChainID: 1, NetworkID: 1, RPC: []string{"https://a.example"}, Explorers: []Explorer{{Name: "x", URL: "not-a-url"}}},
+	}
+	if errs := validateSlice(chains); len(errs) != 1 {
+		t.Fatalf("want 1 error, got %v", errs)
+	}
+}
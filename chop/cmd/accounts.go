@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/accounts"
+)
+
+var (
+	accountsCount        int
+	accountsSeed         string
+	accountsBalanceEther int64
+	accountsPrivateKeys  bool
+	accountsOutput       string
+)
+
+// defaultAccountCount and defaultAccountBalanceEther match anvil's and
+// hardhat's defaults, so `chop accounts`'s output looks familiar.
+const (
+	defaultAccountCount        = 10
+	defaultAccountBalanceEther = 10000
+)
+
+var accountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "Print the deterministic dev accounts chop would fund a session with",
+	RunE:  runAccounts,
+}
+
+func init() {
+	accountsCmd.Flags().IntVar(&accountsCount, "count", defaultAccountCount, "number of accounts to generate")
+	accountsCmd.Flags().StringVar(&accountsSeed, "seed", "", "hex seed to derive accounts from (default: chop's fixed dev seed)")
+	accountsCmd.Flags().Int64Var(&accountsBalanceEther, "balance", defaultAccountBalanceEther, "starting balance per account, in ether")
+	accountsCmd.Flags().BoolVar(&accountsPrivateKeys, "private-keys", false, "also print each account's private key (sensitive: dev use only)")
+	accountsCmd.Flags().StringVar(&accountsOutput, "output", "text", "result format: text or json")
+	rootCmd.AddCommand(accountsCmd)
+}
+
+type accountRow struct {
+	Index      int    `json:"index"`
+	Address    string `json:"address"`
+	Balance    string `json:"balance"`
+	Nonce      uint64 `json:"nonce"`
+	PrivateKey string `json:"privateKey,omitempty"`
+}
+
+// buildAccountRows generates count accounts from seedHex (chop's fixed
+// dev seed if empty), each funded with balanceWei, and returns them in
+// index order. Private keys are included only when includeKeys is
+// true. Two calls with the same seedHex and count always produce the
+// same addresses.
+func buildAccountRows(count int, seedHex string, balanceWei *big.Int, includeKeys bool) ([]accountRow, error) {
+	manager, err := accounts.NewManagerWithOptions(accounts.ManagerOptions{
+		Count:             count,
+		InitialBalanceWei: balanceWei,
+		SeedHex:           seedHex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generating accounts: %w", err)
+	}
+
+	rows := make([]accountRow, 0, count)
+	for _, entry := range manager.GetAllAccounts() {
+		row := accountRow{
+			Index:   entry.Account.Index,
+			Address: fmt.Sprintf("0x%x", entry.Address),
+			Balance: entry.Account.Balance.String(),
+			Nonce:   entry.Account.Nonce,
+		}
+		if includeKeys {
+			if key, ok := manager.GetPrivateKey(entry.Address); ok {
+				row.PrivateKey = "0x" + hex.EncodeToString(key)
+			}
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Index < rows[j].Index })
+	return rows, nil
+}
+
+func runAccounts(cmd *cobra.Command, args []string) error {
+	weiPerEther := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	balanceWei := new(big.Int).Mul(big.NewInt(accountsBalanceEther), weiPerEther)
+
+	rows, err := buildAccountRows(accountsCount, accountsSeed, balanceWei, accountsPrivateKeys)
+	if err != nil {
+		return err
+	}
+
+	if accountsOutput == "json" {
+		out, err := json.Marshal(rows)
+		if err != nil {
+			return fmt.Errorf("encoding accounts: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if accountsPrivateKeys {
+		fmt.Fprintln(os.Stderr, "WARNING: private keys are for local development only — never fund these accounts on a real network")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	header := "INDEX\tADDRESS\tBALANCE (wei)\tNONCE"
+	if accountsPrivateKeys {
+		header += "\tPRIVATE KEY"
+	}
+	fmt.Fprintln(w, header)
+	for _, row := range rows {
+		line := fmt.Sprintf("%d\t%s\t%s\t%d", row.Index, row.Address, row.Balance, row.Nonce)
+		if accountsPrivateKeys {
+			line += "\t" + row.PrivateKey
+		}
+		fmt.Fprintln(w, line)
+	}
+	return w.Flush()
+}
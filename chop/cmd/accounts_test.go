@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBuildAccountRowsIsDeterministicForAFixedSeed(t *testing.T) {
+	balance := big.NewInt(1000)
+	seed := "aa00bb11cc22dd33ee44ff550011223344556677889900112233445566ffee"
+
+	first, err := buildAccountRows(3, seed, balance, false)
+	if err != nil {
+		t.Fatalf("buildAccountRows: %v", err)
+	}
+	second, err := buildAccountRows(3, seed, balance, false)
+	if err != nil {
+		t.Fatalf("buildAccountRows: %v", err)
+	}
+
+	if len(first) != 3 || len(second) != 3 {
+		t.Fatalf("got %d and %d rows, want 3 each", len(first), len(second))
+	}
+	for i := range first {
+		if first[i].Address != second[i].Address {
+			t.Errorf("row %d: got addresses %q and %q, want them equal for the same seed", i, first[i].Address, second[i].Address)
+		}
+		if first[i].PrivateKey != "" {
+			t.Errorf("row %d: got a private key without requesting one", i)
+		}
+	}
+}
+
+func TestBuildAccountRowsDifferentSeedsProduceDifferentAddresses(t *testing.T) {
+	balance := big.NewInt(1000)
+
+	a, err := buildAccountRows(1, "aa00000000000000000000000000000000000000000000000000000000aa", balance, false)
+	if err != nil {
+		t.Fatalf("buildAccountRows: %v", err)
+	}
+	b, err := buildAccountRows(1, "bb00000000000000000000000000000000000000000000000000000000bb", balance, false)
+	if err != nil {
+		t.Fatalf("buildAccountRows: %v", err)
+	}
+
+	if a[0].Address == b[0].Address {
+		t.Errorf("got the same address %q for two different seeds", a[0].Address)
+	}
+}
+
+func TestBuildAccountRowsIncludesPrivateKeysWhenRequested(t *testing.T) {
+	rows, err := buildAccountRows(1, "", big.NewInt(0), true)
+	if err != nil {
+		t.Fatalf("buildAccountRows: %v", err)
+	}
+	if rows[0].PrivateKey == "" {
+		t.Error("expected a private key to be populated")
+	}
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+)
+
+var (
+	benchBytecode   string
+	benchCalldata   string
+	benchGasLimit   uint64
+	benchFork       string
+	benchIterations int
+	benchWarmup     int
+	benchBinary     string
+)
+
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Micro-benchmark repeated execution of a single call",
+	RunE:  runBench,
+}
+
+func init() {
+	benchCmd.Flags().StringVar(&benchBytecode, "bytecode", "", "0x-prefixed contract bytecode to execute")
+	benchCmd.Flags().StringVar(&benchCalldata, "calldata", "", "0x-prefixed calldata")
+	benchCmd.Flags().Uint64Var(&benchGasLimit, "gas-limit", 1_000_000, "gas limit for each call")
+	benchCmd.Flags().StringVar(&benchFork, "fork", string(bytecode.LatestFork), "hardfork to execute under")
+	benchCmd.Flags().IntVar(&benchIterations, "iterations", 1000, "number of timed iterations to run")
+	benchCmd.Flags().IntVar(&benchWarmup, "warmup", 0, "iterations to run and discard before timing starts")
+	benchCmd.Flags().StringVar(&benchBinary, "evm-binary", "./guillotine/zig-out/bin/guillotine", "path to the built guillotine binary")
+	rootCmd.AddCommand(benchCmd)
+}
+
+func runBench(cmd *cobra.Command, args []string) error {
+	if !evm.IsValidHardfork(benchFork) {
+		return fmt.Errorf("--fork: %q is not a supported hardfork; valid options: %s", benchFork, strings.Join(evm.SupportedHardforks(), ", "))
+	}
+	code, err := parseHexArg(benchBytecode)
+	if err != nil {
+		return fmt.Errorf("--bytecode: %w", err)
+	}
+	input, err := parseHexArg(benchCalldata)
+	if err != nil {
+		return fmt.Errorf("--calldata: %w", err)
+	}
+
+	e := evm.NewEVM(benchBinary)
+	ctx := evm.ExecutionContext{
+		Code:     code,
+		Input:    input,
+		GasLimit: benchGasLimit,
+		Fork:     bytecode.Fork(benchFork),
+	}
+
+	stats, err := runBenchmark(e, ctx, benchIterations, benchWarmup)
+	if err != nil {
+		return err
+	}
+	printBenchStats(stats)
+	return nil
+}
+
+// benchStats summarizes a completed benchmark run.
+type benchStats struct {
+	Iterations int
+	OpsPerSec  float64
+	Mean       time.Duration
+	P50        time.Duration
+	P99        time.Duration
+	GasUsed    uint64
+}
+
+// runBenchmark runs ctx through exec iterations times, discarding the
+// first warmup runs from the timing and gas stats. Each iteration
+// starts from ctx as given, so a plain *evm.EVM (not a persistent
+// evm.VMManager) is what "resets between runs".
+func runBenchmark(exec evm.Executor, ctx evm.ExecutionContext, iterations, warmup int) (benchStats, error) {
+	for i := 0; i < warmup; i++ {
+		if _, err := exec.ExecuteCall(ctx); err != nil {
+			return benchStats{}, fmt.Errorf("warmup iteration %d: %w", i, err)
+		}
+	}
+
+	durations := make([]time.Duration, iterations)
+	var gasUsed uint64
+	for i := 0; i < iterations; i++ {
+		start := time.Now()
+		res, err := exec.ExecuteCall(ctx)
+		durations[i] = time.Since(start)
+		if err != nil {
+			return benchStats{}, fmt.Errorf("iteration %d: %w", i, err)
+		}
+		gasUsed = res.GasUsed
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	mean := total / time.Duration(len(durations))
+	var opsPerSec float64
+	if mean > 0 {
+		opsPerSec = float64(time.Second) / float64(mean)
+	}
+	return benchStats{
+		Iterations: iterations,
+		OpsPerSec:  opsPerSec,
+		Mean:       mean,
+		P50:        percentile(durations, 0.50),
+		P99:        percentile(durations, 0.99),
+		GasUsed:    gasUsed,
+	}, nil
+}
+
+// percentile returns the duration at the p-th percentile (0-1) of a
+// slice already sorted ascending.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// printBenchStats writes stats to stdout in chop's plain key/value
+// style, matching printResult's text output.
+func printBenchStats(stats benchStats) {
+	fmt.Printf("iterations: %d\n", stats.Iterations)
+	fmt.Printf("opsPerSec: %.2f\n", stats.OpsPerSec)
+	fmt.Printf("mean: %s\n", stats.Mean)
+	fmt.Printf("p50: %s\n", stats.P50)
+	fmt.Printf("p99: %s\n", stats.P99)
+	fmt.Printf("gasUsed: %d\n", stats.GasUsed)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// fakeBenchExecutor stands in for guillotine in tests: it runs instantly
+// and counts how many times it was called.
+type fakeBenchExecutor struct {
+	calls int
+}
+
+func (f *fakeBenchExecutor) ExecuteCall(ctx evm.ExecutionContext) (types.CallResult, error) {
+	f.calls++
+	return types.CallResult{Status: true, GasUsed: 21000}, nil
+}
+
+func TestRunBenchmarkProducesNonZeroOpsPerSec(t *testing.T) {
+	exec := &fakeBenchExecutor{}
+	stats, err := runBenchmark(exec, evm.ExecutionContext{}, 50, 5)
+	if err != nil {
+		t.Fatalf("runBenchmark: %v", err)
+	}
+	if exec.calls != 55 {
+		t.Errorf("got %d calls, want 55 (50 timed + 5 warmup)", exec.calls)
+	}
+	if stats.OpsPerSec <= 0 {
+		t.Errorf("got opsPerSec=%v, want > 0", stats.OpsPerSec)
+	}
+	if stats.GasUsed != 21000 {
+		t.Errorf("got gasUsed=%d, want 21000", stats.GasUsed)
+	}
+	if stats.Iterations != 50 {
+		t.Errorf("got iterations=%d, want 50 (warmup excluded)", stats.Iterations)
+	}
+}
+
+func TestRunBenchmarkPropagatesExecutionError(t *testing.T) {
+	exec := &failingExecutor{}
+	if _, err := runBenchmark(exec, evm.ExecutionContext{}, 5, 0); err == nil {
+		t.Error("got nil error, want one propagated from ExecuteCall")
+	}
+}
+
+type failingExecutor struct{}
+
+func (failingExecutor) ExecuteCall(ctx evm.ExecutionContext) (types.CallResult, error) {
+	return types.CallResult{}, errors.New("execution failed")
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	buildClean  bool
+	buildOutput string
+)
+
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build the guillotine EVM engine that `chop call`/`chop serve` execute against",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := guillotineDir()
+		if err != nil {
+			return err
+		}
+		if buildClean {
+			if err := runStreamed(dir, "zig", "build", "clean"); err != nil {
+				return fmt.Errorf("clean failed: %w", err)
+			}
+		}
+		buildArgs := []string{"build"}
+		if buildOutput != "" {
+			buildArgs = append(buildArgs, fmt.Sprintf("-Demit-bin=%s", buildOutput))
+		}
+		if err := runStreamed(dir, "zig", buildArgs...); err != nil {
+			return fmt.Errorf("build failed: %w", err)
+		}
+		fmt.Println("guillotine built successfully")
+		return nil
+	},
+}
+
+func init() {
+	buildCmd.Flags().BoolVar(&buildClean, "clean", false, "wipe build artifacts before building")
+	buildCmd.Flags().StringVar(&buildOutput, "output", "", "where the resulting library/binary should be emitted")
+	rootCmd.AddCommand(buildCmd)
+}
+
+// guillotineDir locates the guillotine submodule relative to the
+// monorepo root, returning actionable guidance if it hasn't been
+// checked out rather than a bare exec error.
+func guillotineDir() (string, error) {
+	candidates := []string{filepath.Join("..", "guillotine"), "guillotine"}
+	for _, dir := range candidates {
+		if _, err := os.Stat(filepath.Join(dir, "build.zig")); err == nil {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("guillotine submodule not found (looked in %v) — run `git submodule update --init guillotine`", candidates)
+}
+
+// runStreamed runs name/args in dir, passing stdout/stderr through to
+// the user so long builds show progress.
+func runStreamed(dir, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/genesis"
+	"github.com/evmts/chop/internal/render"
+	"github.com/evmts/chop/internal/types"
+)
+
+var (
+	callBytecode     string
+	callCalldata     string
+	callGasLimit     uint64
+	callFork         string
+	callTrace        bool
+	callTraceFormat  string
+	callGasProfile   bool
+	callCaptureState bool
+	callBinary       string
+	callOutput       string
+	callListForks    bool
+	callAccessList   string
+	callBlobHashes   string
+	callGenesisPath  string
+)
+
+var callCmd = &cobra.Command{
+	Use:   "call",
+	Short: "Execute a single EVM call against guillotine",
+	RunE:  runCall,
+}
+
+func init() {
+	callCmd.Flags().StringVar(&callBytecode, "bytecode", "", "0x-prefixed contract bytecode to execute")
+	callCmd.Flags().StringVar(&callCalldata, "calldata", "", "0x-prefixed calldata")
+	callCmd.Flags().Uint64Var(&callGasLimit, "gas-limit", 1_000_000, "gas limit for the call")
+	callCmd.Flags().StringVar(&callFork, "fork", string(bytecode.LatestFork), "hardfork to execute under")
+	callCmd.Flags().BoolVar(&callTrace, "trace", false, "emit a structured step trace alongside the result")
+	callCmd.Flags().StringVar(&callTraceFormat, "trace-format", "table", "trace output format: table or json")
+	callCmd.Flags().BoolVar(&callGasProfile, "gas-profile", false, "with --trace, show a gas-by-category bar chart (arithmetic, storage, memory, calls)")
+	callCmd.Flags().BoolVar(&callCaptureState, "capture-state", false, "capture the final stack and memory at the moment execution stopped (always on with --log-level debug)")
+	callCmd.Flags().StringVar(&callBinary, "evm-binary", "./guillotine/zig-out/bin/guillotine", "path to the built guillotine binary")
+	callCmd.Flags().StringVar(&callOutput, "output", "text", "result format: text or json")
+	callCmd.Flags().BoolVar(&callListForks, "list-forks", false, "print supported hardforks in chronological order and exit")
+	callCmd.Flags().StringVar(&callAccessList, "access-list", "", `JSON access list to pre-warm (EIP-2930), e.g. '[{"address":"0x...","storageKeys":["0x..."]}]'`)
+	callCmd.Flags().StringVar(&callBlobHashes, "blob-hashes", "", `JSON array of 0x-prefixed blob versioned hashes (EIP-4844) for BLOBHASH to return, e.g. '["0x01..."]'`)
+	callCmd.Flags().StringVar(&callGenesisPath, "genesis", "", "anvil/hardhat-style genesis JSON to pre-seed accounts, code, and storage for this call")
+	rootCmd.AddCommand(callCmd)
+}
+
+func runCall(cmd *cobra.Command, args []string) error {
+	if callListForks {
+		printForks()
+		return nil
+	}
+	if !evm.IsValidHardfork(callFork) {
+		return fmt.Errorf("--fork: %q is not a supported hardfork; valid options: %s", callFork, strings.Join(evm.SupportedHardforks(), ", "))
+	}
+
+	code, err := parseHexArg(callBytecode)
+	if err != nil {
+		return fmt.Errorf("--bytecode: %w", err)
+	}
+	input, err := parseHexArg(callCalldata)
+	if err != nil {
+		return fmt.Errorf("--calldata: %w", err)
+	}
+	accessList, err := parseAccessListArg(callAccessList)
+	if err != nil {
+		return fmt.Errorf("--access-list: %w", err)
+	}
+	blobHashes, err := parseBlobHashesArg(callBlobHashes)
+	if err != nil {
+		return fmt.Errorf("--blob-hashes: %w", err)
+	}
+	preState, err := loadGenesisPreState(callGenesisPath)
+	if err != nil {
+		return fmt.Errorf("--genesis: %w", err)
+	}
+
+	e := evm.NewEVM(callBinary)
+	ctx := evm.ExecutionContext{
+		Code:                code,
+		Input:               input,
+		GasLimit:            callGasLimit,
+		Fork:                bytecode.Fork(callFork),
+		CaptureState:        callCaptureState || logLevel == "debug",
+		AccessList:          accessList,
+		BlobVersionedHashes: blobHashes,
+		PreState:            preState,
+	}
+
+	if callTrace {
+		res, trace, err := e.ExecuteWithTrace(ctx)
+		if err != nil {
+			return err
+		}
+		printTrace(trace, callTraceFormat)
+		if callGasProfile {
+			fmt.Print(render.RenderGasProfile(evm.GasProfile(trace)))
+		}
+		// In debug mode the trace itself already carries per-step gas
+		// and result context, so skip the redundant summary line.
+		if logLevel != "debug" {
+			return printResult(res)
+		}
+		return nil
+	}
+
+	res, err := e.ExecuteCall(ctx)
+	if err != nil {
+		return err
+	}
+	return printResult(res)
+}
+
+// printResult writes res to stdout in either chop's human-oriented
+// text format or the stable JSON shape, per --output.
+func printResult(res types.CallResult) error {
+	if callOutput != "json" {
+		fmt.Println(res.String())
+		return nil
+	}
+	data, err := render.CallResultJSON(res)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// parseHexArg parses a 0x-prefixed hex string into bytes. An empty
+// string is treated as no data. If s starts with "@", the rest is
+// treated as a path to a file holding the hex, which may be wrapped
+// across lines or have a trailing newline.
+func parseHexArg(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(s, "@") {
+		path := s[1:]
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		s = stripWhitespace(string(data))
+	}
+	s = strings.TrimPrefix(s, "0x")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %w", err)
+	}
+	return b, nil
+}
+
+// parseAccessListArg parses s as a JSON array of access list entries.
+// An empty string is treated as no access list.
+func parseAccessListArg(s string) ([]types.AccessListEntry, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var accessList []types.AccessListEntry
+	if err := json.Unmarshal([]byte(s), &accessList); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	return accessList, nil
+}
+
+// parseBlobHashesArg parses s as a JSON array of 0x-prefixed 32-byte
+// hashes. An empty string is treated as no blob hashes.
+func parseBlobHashesArg(s string) ([]types.Hash, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var raw []string
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	hashes := make([]types.Hash, len(raw))
+	for i, h := range raw {
+		b, err := parseHexArg(h)
+		if err != nil {
+			return nil, fmt.Errorf("hash %d: %w", i, err)
+		}
+		if len(b) != len(types.Hash{}) {
+			return nil, fmt.Errorf("hash %d: want %d bytes, got %d", i, len(types.Hash{}), len(b))
+		}
+		copy(hashes[i][:], b)
+	}
+	return hashes, nil
+}
+
+// loadGenesisPreState loads path, if set, as an anvil/hardhat-style
+// genesis JSON and converts each account into a types.PreStateEntry
+// for evm.ExecutionContext.PreState. An empty path is not an error -
+// it just means no genesis file was given.
+func loadGenesisPreState(path string) ([]types.PreStateEntry, error) {
+	if path == "" {
+		return nil, nil
+	}
+	accountsList, err := genesis.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	preState := make([]types.PreStateEntry, len(accountsList))
+	for i, a := range accountsList {
+		entry, err := a.PreStateEntry()
+		if err != nil {
+			return nil, err
+		}
+		preState[i] = entry
+	}
+	return preState, nil
+}
+
+// stripWhitespace removes every whitespace character (spaces, tabs,
+// newlines) so a hex file wrapped across multiple lines still parses.
+func stripWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// printForks prints every hardfork chop accepts for --fork/--hardfork
+// flags, oldest to newest, alongside a short activation note.
+func printForks() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	for _, f := range evm.SupportedHardforks() {
+		fmt.Fprintf(w, "%s\t%s\n", f, evm.HardforkNote(f))
+	}
+	w.Flush()
+}
+
+// printTrace renders a step trace either as an aligned table or, with
+// format "json", one JSON array to stdout for machine consumption.
+func printTrace(steps []evm.TraceStep, format string) {
+	if format == "json" {
+		_ = json.NewEncoder(os.Stdout).Encode(steps)
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "PC\tOP\tGAS\tDEPTH")
+	for _, s := range steps {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\n", s.PC, s.Op, s.GasRemaining, s.StackDepth)
+	}
+	w.Flush()
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseHexArgInline(t *testing.T) {
+	got, err := parseHexArg("0xdeadbeef")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []byte{0xde, 0xad, 0xbe, 0xef}
+	if string(got) != string(want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestParseHexArgEmpty(t *testing.T) {
+	got, err := parseHexArg("")
+	if err != nil || got != nil {
+		t.Errorf("want nil, nil for empty input, got %x, %v", got, err)
+	}
+}
+
+func TestParseHexArgFromFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "code.hex")
+	if err := os.WriteFile(path, []byte("0xdead\nbeef\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := parseHexArg("@" + path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []byte{0xde, 0xad, 0xbe, 0xef}
+	if string(got) != string(want) {
+		t.Errorf("got %x, want %x", got, want)
+	}
+}
+
+func TestParseHexArgMissingFile(t *testing.T) {
+	if _, err := parseHexArg("@/no/such/file.hex"); err == nil {
+		t.Error("want error for missing file")
+	}
+}
+
+func TestParseHexArgInvalidHexInFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bad.hex")
+	if err := os.WriteFile(path, []byte("not hex"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseHexArg("@" + path); err == nil {
+		t.Error("want error for invalid hex in file")
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/config"
+)
+
+var (
+	configPath     string
+	configPort     int
+	configHardfork string
+	configGasLimit uint64
+	configForkURL  string
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and manage chop's config file",
+}
+
+var configPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Print the effective config as JSON, annotated with each field's source",
+	RunE:  runConfigPrint,
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the resolved config file path",
+	RunE:  runConfigPath,
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write a commented default config file",
+	RunE:  runConfigInit,
+}
+
+func init() {
+	configCmd.PersistentFlags().StringVar(&configPath, "config", "", "path to the config file, overriding $CHOP_CONFIG and the default location")
+
+	configPrintCmd.Flags().IntVar(&configPort, "port", 0, "override Port for this invocation")
+	configPrintCmd.Flags().StringVar(&configHardfork, "hardfork", "", "override Hardfork for this invocation")
+	configPrintCmd.Flags().Uint64Var(&configGasLimit, "gas-limit", 0, "override GasLimit for this invocation")
+	configPrintCmd.Flags().StringVar(&configForkURL, "fork-url", "", "override ForkURL for this invocation")
+
+	configCmd.AddCommand(configPrintCmd, configPathCmd, configInitCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// configReport is config print's JSON shape: the effective config
+// alongside where each field's value came from.
+type configReport struct {
+	Config  config.Config            `json:"config"`
+	Sources map[string]config.Source `json:"sources"`
+}
+
+func runConfigPrint(cmd *cobra.Command, args []string) error {
+	cfg, sources, err := config.LoadWithSources(configPath)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("port") {
+		cfg.Port = configPort
+		sources["port"] = config.SourceFlag
+	}
+	if flags.Changed("hardfork") {
+		cfg.Hardfork = configHardfork
+		sources["hardfork"] = config.SourceFlag
+	}
+	if flags.Changed("gas-limit") {
+		cfg.GasLimit = configGasLimit
+		sources["gasLimit"] = config.SourceFlag
+	}
+	if flags.Changed("fork-url") {
+		cfg.ForkURL = configForkURL
+		sources["forkURL"] = config.SourceFlag
+	}
+
+	data, err := json.MarshalIndent(configReport{Config: cfg, Sources: sources}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func runConfigPath(cmd *cobra.Command, args []string) error {
+	path, err := config.Path(configPath)
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+	return nil
+}
+
+func runConfigInit(cmd *cobra.Command, args []string) error {
+	path, err := config.WriteDefault(configPath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("wrote default config to %s\n", path)
+	return nil
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evmts/chop/internal/evm"
+)
+
+// printCoverageReport writes an evm.CoverageReport in chop's plain
+// key/value style, shared by `chop diff --category --coverage` and
+// `chop load-fixture --all --coverage`.
+func printCoverageReport(report evm.CoverageReport) {
+	fmt.Printf("coverage: %d/%d opcodes at %s\n", report.Covered, report.Total, report.Fork)
+	if len(report.Uncovered) > 0 {
+		fmt.Printf("uncovered: %s\n", strings.Join(report.Uncovered, ", "))
+	}
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/fixtures"
+)
+
+var deleteFixtureCmd = &cobra.Command{
+	Use:   "delete-fixture <name>",
+	Short: "Delete a saved fixture",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := fixtures.Delete(args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("deleted fixture %q\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(deleteFixtureCmd)
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/diff"
+	"github.com/evmts/chop/internal/evm"
+)
+
+var (
+	diffBytecode        string
+	diffCalldata        string
+	diffGasLimit        uint64
+	diffFork            string
+	diffReference       string
+	diffReferenceBinary string
+	diffBinary          string
+	diffCategory        string
+	diffFixture         string
+	diffOutputPath      string
+	diffFormat          string
+	diffConcurrency     int
+	diffCoverage        bool
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare chop's EVM execution against a reference implementation",
+	RunE:  runDiff,
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffBytecode, "bytecode", "", "0x-prefixed contract bytecode to execute")
+	diffCmd.Flags().StringVar(&diffCalldata, "calldata", "", "0x-prefixed calldata")
+	diffCmd.Flags().Uint64Var(&diffGasLimit, "gas-limit", 1_000_000, "gas limit for the call")
+	diffCmd.Flags().StringVar(&diffFork, "fork", string(bytecode.LatestFork), "hardfork to execute under")
+	diffCmd.Flags().StringVar(&diffReference, "reference", "revme", "reference implementation to diff against: revme or geth")
+	diffCmd.Flags().StringVar(&diffReferenceBinary, "reference-binary", "", "path to the reference implementation's binary; defaults to resolving \"revme\"/\"evm\" on PATH")
+	diffCmd.Flags().StringVar(&diffBinary, "evm-binary", "./guillotine/zig-out/bin/guillotine", "path to the built guillotine binary")
+	diffCmd.Flags().StringVar(&diffCategory, "category", "", "directory of saved fixtures to diff instead of a single --bytecode/--calldata run")
+	diffCmd.Flags().StringVar(&diffFixture, "fixture", "", "standard execution-spec-tests state-test JSON to run against chop; may cover multiple forks and cases")
+	diffCmd.Flags().StringVar(&diffOutputPath, "output", "", "path to write the JSON report to (requires --format json)")
+	diffCmd.Flags().StringVar(&diffFormat, "format", "", "report format for --category runs: \"json\" or empty for human-readable")
+	diffCmd.Flags().IntVar(&diffConcurrency, "concurrency", 0, "max fixtures to diff at once for --category runs; 0 uses GOMAXPROCS")
+	diffCmd.Flags().BoolVar(&diffCoverage, "coverage", false, "with --category, report which opcodes the category's fixtures exercised")
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	opts := diff.Options{
+		GasLimit:        diffGasLimit,
+		Fork:            bytecode.Fork(diffFork),
+		Reference:       diff.Reference(diffReference),
+		ReferenceBinary: diffReferenceBinary,
+		OutputPath:      diffOutputPath,
+		Format:          diffFormat,
+		Concurrency:     diffConcurrency,
+	}
+	executor := evm.NewEVM(diffBinary)
+
+	if diffCategory != "" {
+		_, err := diff.RunCategory(func() evm.Executor { return evm.NewEVM(diffBinary) }, diffCategory, opts)
+		if err != nil {
+			return err
+		}
+		if diffCoverage {
+			return reportCategoryCoverage(diffCategory, opts.Fork)
+		}
+		return nil
+	}
+
+	if diffFixture != "" {
+		return runDiffFixture(cmd, executor)
+	}
+
+	code, err := parseHexArg(diffBytecode)
+	if err != nil {
+		return fmt.Errorf("--bytecode: %w", err)
+	}
+	input, err := parseHexArg(diffCalldata)
+	if err != nil {
+		return fmt.Errorf("--calldata: %w", err)
+	}
+	opts.Bytecode = code
+	opts.Calldata = input
+
+	result, err := diff.Run(executor, opts)
+	if err != nil {
+		return err
+	}
+
+	if result.Matched {
+		fmt.Printf("match: gas=%d output=0x%x\n", result.ChopGas, result.ChopOutput)
+		return nil
+	}
+	fmt.Printf("mismatch: %s\n", result.Mismatch)
+	fmt.Printf("  chop:      gas=%d output=0x%x\n", result.ChopGas, result.ChopOutput)
+	fmt.Printf("  %s: gas=%d output=0x%x\n", diffReference, result.RefGas, result.RefOutput)
+	return fmt.Errorf("diff: chop and %s disagree", diffReference)
+}
+
+// reportCategoryCoverage re-runs every fixture in dir through a fresh
+// guillotine instance with tracing on, collects which opcodes ran, and
+// prints the coverage report. Run separately from the diff.RunCategory
+// pass above since evm.Executor (which RunCategory works against, so
+// tests can fake it) doesn't expose tracing - only the concrete *evm.EVM
+// does.
+func reportCategoryCoverage(dir string, fork bytecode.Fork) error {
+	fxs, err := diff.LoadCategory(dir)
+	if err != nil {
+		return err
+	}
+	e := evm.NewEVM(diffBinary)
+	coverage := evm.NewCoverage()
+	for _, fx := range fxs {
+		_, trace, err := e.ExecuteWithTrace(evm.ExecutionContext{
+			Code:     fx.Bytecode,
+			Input:    fx.Calldata,
+			From:     fx.Caller,
+			Value:    fx.Value,
+			GasLimit: fx.GasLimit,
+			Fork:     fork,
+			Block:    fx.Block,
+			PreState: fx.PreState,
+		})
+		if err != nil {
+			return fmt.Errorf("coverage: fixture %q: %w", fx.Name, err)
+		}
+		coverage.Record(trace)
+	}
+	printCoverageReport(coverage.Report(fork))
+	return nil
+}
+
+// runDiffFixture handles `chop diff --fixture`: parse a standard
+// execution-spec-tests state-test file (possibly covering many forks
+// and cases) and run every case through executor. --fork, if the user
+// set it, filters to that one fork; left at its default it runs every
+// fork the file covers.
+func runDiffFixture(cmd *cobra.Command, executor evm.Executor) error {
+	data, err := os.ReadFile(diffFixture)
+	if err != nil {
+		return fmt.Errorf("--fixture: %w", err)
+	}
+
+	forkFilter := ""
+	if cmd.Flags().Changed("fork") {
+		forkFilter = diffFork
+	}
+
+	cases, err := diff.ParseSpecFixture(data, forkFilter)
+	if err != nil {
+		return err
+	}
+
+	results := diff.RunSpecCases(executor, cases)
+	failed := 0
+	for _, r := range results {
+		if r.Passed {
+			fmt.Printf("PASS %s\n", r.Name)
+			continue
+		}
+		failed++
+		fmt.Printf("FAIL %s: %s\n", r.Name, r.Reason)
+	}
+	if failed > 0 {
+		return fmt.Errorf("diff: %d of %d cases failed", failed, len(results))
+	}
+	return nil
+}
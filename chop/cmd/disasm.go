@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+var (
+	disasmBytecode string
+	disasmFormat   string
+	disasmBlocks   bool
+)
+
+var disasmCmd = &cobra.Command{
+	Use:   "disasm",
+	Short: "Disassemble raw EVM bytecode",
+	RunE:  runDisasm,
+}
+
+func init() {
+	disasmCmd.Flags().StringVar(&disasmBytecode, "bytecode", "", "0x-prefixed contract bytecode, or @file to read it from a file")
+	disasmCmd.Flags().StringVar(&disasmFormat, "format", "text", "output format: text or json")
+	disasmCmd.Flags().BoolVar(&disasmBlocks, "blocks", false, "group output by basic block")
+	rootCmd.AddCommand(disasmCmd)
+}
+
+func runDisasm(cmd *cobra.Command, args []string) error {
+	code, err := parseHexArg(disasmBytecode)
+	if err != nil {
+		return fmt.Errorf("--bytecode: %w", err)
+	}
+
+	result, err := bytecode.AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		return err
+	}
+
+	if disasmFormat == "json" {
+		return printDisasmJSON(os.Stdout, result)
+	}
+	if disasmBlocks {
+		printDisasmBlocks(os.Stdout, result)
+		return nil
+	}
+	printDisasmFlat(os.Stdout, result.Instructions)
+	return nil
+}
+
+// disasmInstructionJSON is the stable JSON shape for one instruction
+// in --format json output.
+type disasmInstructionJSON struct {
+	PC     int    `json:"pc"`
+	Opcode string `json:"opcode"`
+	Hex    string `json:"hex"`
+	Push   string `json:"push,omitempty"`
+	Gas    int    `json:"gas"`
+}
+
+func printDisasmJSON(w io.Writer, result *bytecode.DisassemblyResult) error {
+	rows := make([]disasmInstructionJSON, len(result.Instructions))
+	for i, inst := range result.Instructions {
+		rows[i] = disasmInstructionJSON{
+			PC:     inst.PC,
+			Opcode: inst.Opcode.Name,
+			Hex:    fmt.Sprintf("0x%02x", inst.Opcode.Byte),
+			Gas:    inst.Opcode.GasAt(bytecode.LatestFork),
+		}
+		if len(inst.Push) > 0 {
+			rows[i].Push = "0x" + hex.EncodeToString(inst.Push)
+		}
+	}
+	return json.NewEncoder(w).Encode(rows)
+}
+
+func printDisasmFlat(w io.Writer, instructions []bytecode.Instruction) {
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "PC\tOPCODE\tHEX\tPUSH\tGAS")
+	for _, inst := range instructions {
+		fmt.Fprintln(tw, disasmRow(inst))
+	}
+	tw.Flush()
+}
+
+func printDisasmBlocks(w io.Writer, result *bytecode.DisassemblyResult) {
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "PC\tOPCODE\tHEX\tPUSH\tGAS")
+	for i, block := range result.BasicBlocks {
+		for _, inst := range block.Instructions {
+			fmt.Fprintln(tw, disasmRow(inst))
+		}
+		gas := bytecode.CalculateBlockGas(block, bytecode.LatestFork)
+		fmt.Fprintf(tw, "Block %d/%d • PC %d-%d • Gas %d\n", i+1, len(result.BasicBlocks), block.StartPC, block.EndPC, gas)
+	}
+	tw.Flush()
+}
+
+func disasmRow(inst bytecode.Instruction) string {
+	push := ""
+	if len(inst.Push) > 0 {
+		push = "0x" + hex.EncodeToString(inst.Push)
+	}
+	return fmt.Sprintf("%d\t%s\t0x%02x\t%s\t%d", inst.PC, inst.Opcode.Name, inst.Opcode.Byte, push, inst.Opcode.GasAt(bytecode.LatestFork))
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+// disasmGoldenCode is PUSH1 0x00 PUSH1 0x01 ADD STOP: small enough to
+// assert the exact rendering of every output mode against.
+var disasmGoldenCode = []byte{0x60, 0x00, 0x60, 0x01, 0x01, 0x00}
+
+func TestPrintDisasmFlatGolden(t *testing.T) {
+	result, err := bytecode.AnalyzeBytecodeFromBytes(disasmGoldenCode)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	var buf bytes.Buffer
+	printDisasmFlat(&buf, result.Instructions)
+
+	want := "PC  OPCODE  HEX   PUSH  GAS\n" +
+		"0   PUSH1   0x60  0x00  3\n" +
+		"2   PUSH1   0x60  0x01  3\n" +
+		"4   ADD     0x01        3\n" +
+		"5   STOP    0x00        0\n"
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestPrintDisasmBlocksGoldenIncludesFooter(t *testing.T) {
+	result, err := bytecode.AnalyzeBytecodeFromBytes(disasmGoldenCode)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	var buf bytes.Buffer
+	printDisasmBlocks(&buf, result)
+
+	if got := buf.String(); !strings.Contains(got, "Block 1/1 • PC 0-5 • Gas 9") {
+		t.Errorf("got:\n%s\nwant a footer line Block 1/1 • PC 0-5 • Gas 9", got)
+	}
+}
+
+func TestPrintDisasmJSONRoundtrips(t *testing.T) {
+	result, err := bytecode.AnalyzeBytecodeFromBytes(disasmGoldenCode)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := printDisasmJSON(&buf, result); err != nil {
+		t.Fatalf("printDisasmJSON: %v", err)
+	}
+
+	var rows []disasmInstructionJSON
+	if err := json.Unmarshal(buf.Bytes(), &rows); err != nil {
+		t.Fatalf("decoding output: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want 4", len(rows))
+	}
+	if rows[0].Opcode != "PUSH1" || rows[0].Push != "0x00" {
+		t.Errorf("got first row %+v, want PUSH1 pushing 0x00", rows[0])
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fixtures"
+	"github.com/evmts/chop/internal/types"
+)
+
+var (
+	loadFixtureAll      bool
+	loadFixtureFailFast bool
+	loadFixtureJSON     bool
+	loadFixtureCoverage bool
+)
+
+var loadFixtureCmd = &cobra.Command{
+	Use:   "load-fixture [name]",
+	Short: "Execute a saved fixture",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runLoadFixture,
+}
+
+func init() {
+	loadFixtureCmd.Flags().BoolVar(&loadFixtureAll, "all", false, "run every saved fixture as a smoke test")
+	loadFixtureCmd.Flags().BoolVar(&loadFixtureFailFast, "fail-fast", false, "stop at the first mismatch (with --all)")
+	loadFixtureCmd.Flags().BoolVar(&loadFixtureJSON, "json", false, "print the summary as JSON for CI consumption")
+	loadFixtureCmd.Flags().BoolVar(&loadFixtureCoverage, "coverage", false, "with --all, report which opcodes the run fixtures exercised")
+	rootCmd.AddCommand(loadFixtureCmd)
+}
+
+// fixtureRun is one row of the `chop load-fixture --all` summary.
+type fixtureRun struct {
+	Name    string `json:"name"`
+	Success bool   `json:"success"`
+	GasUsed uint64 `json:"gasUsed"`
+	Pass    bool   `json:"pass"`
+	Diff    string `json:"diff,omitempty"`
+}
+
+func runLoadFixture(cmd *cobra.Command, args []string) error {
+	if loadFixtureAll == (len(args) == 1) {
+		return fmt.Errorf("pass exactly one of a fixture name or --all")
+	}
+
+	names := args
+	if loadFixtureAll {
+		var err error
+		names, err = fixtures.List()
+		if err != nil {
+			return err
+		}
+	}
+
+	e := evm.NewEVM(callBinary)
+	var coverage *evm.Coverage
+	if loadFixtureCoverage {
+		coverage = evm.NewCoverage()
+	}
+
+	var runs []fixtureRun
+	anyFailed := false
+	for _, name := range names {
+		fx, err := fixtures.Load(name)
+		if err != nil {
+			return err
+		}
+		ctx := evm.ExecutionContext{
+			Code:     fx.Bytecode,
+			Input:    fx.Calldata,
+			From:     fx.Caller,
+			Value:    fx.Value,
+			GasLimit: fx.GasLimit,
+			Fork:     bytecode.LatestFork,
+			Block:    fx.Block,
+			PreState: fx.PreState,
+		}
+		var result types.CallResult
+		if coverage != nil {
+			var trace []evm.TraceStep
+			result, trace, err = e.ExecuteWithTrace(ctx)
+			coverage.Record(trace)
+		} else {
+			result, err = e.ExecuteCall(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("fixture %q: %w", name, err)
+		}
+		pass, diff := fixtures.Compare(fx, result)
+		if !pass {
+			anyFailed = true
+		}
+		runs = append(runs, fixtureRun{Name: name, Success: result.Status, GasUsed: result.GasUsed, Pass: pass, Diff: diff})
+		if !pass && loadFixtureFailFast {
+			break
+		}
+	}
+
+	if loadFixtureAll {
+		printFixtureRuns(runs)
+	} else {
+		printSingleFixtureRun(runs[0])
+	}
+	if coverage != nil {
+		printCoverageReport(coverage.Report(bytecode.LatestFork))
+	}
+	if anyFailed {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// printSingleFixtureRun prints a clear PASS/FAIL line (with the diff
+// on failure) for `chop load-fixture <name>` without --all.
+func printSingleFixtureRun(r fixtureRun) {
+	if loadFixtureJSON {
+		_ = json.NewEncoder(os.Stdout).Encode(r)
+		return
+	}
+	if r.Pass {
+		fmt.Printf("PASS %s (success=%v gasUsed=%d)\n", r.Name, r.Success, r.GasUsed)
+		return
+	}
+	fmt.Printf("FAIL %s: %s\n", r.Name, r.Diff)
+}
+
+func printFixtureRuns(runs []fixtureRun) {
+	if loadFixtureJSON {
+		_ = json.NewEncoder(os.Stdout).Encode(runs)
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSUCCESS\tGAS USED\tPASS")
+	for _, r := range runs {
+		status := "PASS"
+		if !r.Pass {
+			status = "FAIL"
+		}
+		fmt.Fprintf(w, "%s\t%v\t%d\t%s\n", r.Name, r.Success, r.GasUsed, status)
+	}
+	w.Flush()
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+var opcodeFork string
+
+var opcodeCmd = &cobra.Command{
+	Use:   "opcode <mnemonic|hex>",
+	Short: "Look up an opcode's semantics and gas cost",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		op, err := bytecode.Lookup(args[0])
+		if err != nil {
+			return err
+		}
+		fork := bytecode.Fork(opcodeFork)
+		fmt.Printf("%s (0x%02x)\n", op.Name, op.Byte)
+		fmt.Printf("  stack: %d in, %d out\n", op.StackIn, op.StackOut)
+		fmt.Printf("  gas (%s): %d\n", fork, op.GasAt(fork))
+		fmt.Printf("  since: %s", op.Since)
+		if op.EIP != "" {
+			fmt.Printf(" (%s)", op.EIP)
+		}
+		fmt.Println()
+		if !op.AvailableAt(fork) {
+			fmt.Printf("  note: not available under %s\n", fork)
+		}
+		fmt.Printf("  %s\n", op.Description)
+		return nil
+	},
+}
+
+func init() {
+	opcodeCmd.Flags().StringVar(&opcodeFork, "fork", string(bytecode.LatestFork), "hardfork to resolve gas/availability against")
+	rootCmd.AddCommand(opcodeCmd)
+}
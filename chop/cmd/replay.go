@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/state"
+)
+
+var replayHardfork string
+
+var replayCmd = &cobra.Command{
+	Use:   "replay <index|timestamp>",
+	Short: "Re-execute a persisted call from the TUI's history",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runReplay,
+}
+
+func init() {
+	replayCmd.Flags().StringVar(&replayHardfork, "hardfork", "", "override the fork used for replay (defaults to the fork recorded with the call)")
+	rootCmd.AddCommand(replayCmd)
+}
+
+func runReplay(cmd *cobra.Command, args []string) error {
+	stored, err := lookupStoredCall(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := stored.Context
+	if replayHardfork != "" {
+		ctx.Fork = bytecode.Fork(replayHardfork)
+	}
+
+	e := evm.NewEVM(callBinary)
+	result, err := e.ExecuteCall(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(result.String())
+	if stored.Expected != nil {
+		if result.Status == stored.Expected.Status && string(result.Output) == string(stored.Expected.Output) {
+			fmt.Println("matches stored expected result")
+		} else {
+			fmt.Println("DIFFERS from stored expected result")
+		}
+	}
+	return nil
+}
+
+// lookupStoredCall resolves arg as either an index into the stored
+// call history or, if no call exists at that index, a Unix timestamp
+// (seconds) to find the nearest recorded call to.
+func lookupStoredCall(arg string) (state.StoredCall, error) {
+	n, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return state.StoredCall{}, fmt.Errorf("expected an index or unix timestamp, got %q", arg)
+	}
+	if sc, err := state.ByIndex(int(n)); err == nil {
+		return sc, nil
+	}
+	return state.ByTimestamp(time.Unix(n, 0))
+}
@@ -0,0 +1,20 @@
+// Package cmd wires up chop's CLI commands.
+package cmd
+
+import "github.com/spf13/cobra"
+
+var logLevel string
+
+var rootCmd = &cobra.Command{
+	Use:   "chop",
+	Short: "chop is a local EVM call debugger and RPC dev server",
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "log verbosity: debug, info, warn, error")
+}
+
+// Execute runs the root command.
+func Execute() error {
+	return rootCmd.Execute()
+}
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/chains"
+	"github.com/evmts/chop/internal/config"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fork"
+	"github.com/evmts/chop/internal/genesis"
+	"github.com/evmts/chop/internal/history"
+	"github.com/evmts/chop/internal/render"
+	"github.com/evmts/chop/internal/rpcserver"
+)
+
+var (
+	serveAddr             string
+	serveBinary           string
+	serveCorsOrigins      []string
+	serveChain            string
+	serveChainID          uint64
+	serveForkURL          string
+	serveBlockTime        time.Duration
+	serveConfigPath       string
+	serveShowKeys         bool
+	serveMaxBatch         int
+	serveRateLimit        int
+	serveAuthToken        string
+	serveGenesisPath      string
+	serveDumpStatePath    string
+	serveDumpStateStorage bool
+	serveHistoryFile      string
+)
+
+// serveAccountCount and serveAccountBalanceEther match anvil's and
+// hardhat's defaults for the accounts a dev server funds on startup.
+const (
+	serveAccountCount        = 10
+	serveAccountBalanceEther = 10000
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Start the chop RPC server",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load(serveConfigPath)
+		if err != nil {
+			return err
+		}
+		if err := config.Validate(cfg); err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("fork-url") && cfg.ForkURL != "" {
+			serveForkURL = cfg.ForkURL
+		}
+		if !cmd.Flags().Changed("addr") {
+			serveAddr = fmt.Sprintf("127.0.0.1:%d", cfg.Port)
+		}
+		if !cmd.Flags().Changed("cors-origin") && len(cfg.CorsOrigins) > 0 {
+			serveCorsOrigins = cfg.CorsOrigins
+		}
+		if !cmd.Flags().Changed("rate-limit") && cfg.RateLimit != 0 {
+			serveRateLimit = cfg.RateLimit
+		}
+		if !cmd.Flags().Changed("auth-token") && cfg.AuthToken != "" {
+			serveAuthToken = cfg.AuthToken
+		}
+		if serveChain != "" {
+			resolved, ok := resolveChain(serveChain)
+			if !ok {
+				return fmt.Errorf("--chain: %q is not a known chain ID or short name", serveChain)
+			}
+			if !cmd.Flags().Changed("chain-id") {
+				serveChainID = resolved.ID
+			}
+			if !cmd.Flags().Changed("fork-url") && cfg.ForkURL == "" && resolved.RPCURL != "" {
+				serveForkURL = resolved.RPCURL
+			}
+		}
+
+		chain := blockchain.New()
+		weiPerEther := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+		balanceWei := new(big.Int).Mul(big.NewInt(serveAccountBalanceEther), weiPerEther)
+		fundedAccounts, err := accounts.NewManagerWithOptions(accounts.ManagerOptions{
+			Count:             serveAccountCount,
+			InitialBalanceWei: balanceWei,
+		})
+		if err != nil {
+			return fmt.Errorf("funding dev accounts: %w", err)
+		}
+		chain.Accounts = fundedAccounts
+		if serveGenesisPath != "" {
+			genesisAccounts, err := genesis.Load(serveGenesisPath)
+			if err != nil {
+				return fmt.Errorf("--genesis: %w", err)
+			}
+			genesis.ApplyToChain(chain, genesisAccounts)
+		}
+		if serveHistoryFile != "" {
+			jsonlHistory, err := history.NewJSONLStore(serveHistoryFile)
+			if err != nil {
+				return fmt.Errorf("--history-file: %w", err)
+			}
+			chain.History = jsonlHistory
+		}
+		printAccounts(os.Stdout, fundedAccounts, accounts.DefaultSeedHex, serveShowKeys)
+
+		srv := rpcserver.New()
+		srv.SetAllowedOrigins(serveCorsOrigins)
+		srv.SetMaxBatchSize(serveMaxBatch)
+		srv.SetRateLimit(serveRateLimit)
+		srv.SetAuthToken(serveAuthToken)
+		srv.SetGasPrice(cfg.GasPrice)
+		srv.SetVerbose(logLevel == "debug" || cfg.Verbose)
+		srv.SetLogFormat(cfg.LogFormat)
+		if err := srv.SetChainID(serveChainID); err != nil {
+			return err
+		}
+		rpcserver.RegisterChopMethods(srv, chain)
+		rpcserver.RegisterHealthCheck(srv, chain)
+		rpcserver.RegisterEthMethods(srv, chain, evm.NewEVM(serveBinary))
+		rpcserver.RegisterSubscriptions(srv, chain)
+		if cfg.EnableCheats {
+			rpcserver.RegisterEvmMethods(srv, chain)
+		}
+		if serveForkURL != "" {
+			forker, err := fork.NewForker(fork.Config{URL: serveForkURL})
+			if err != nil {
+				return err
+			}
+			rpcserver.RegisterForkMethods(srv, forker)
+		}
+		if serveBlockTime > 0 {
+			chain.StartAutoMine(serveBlockTime)
+			defer chain.StopAutoMine()
+		}
+
+		reloadCh := make(chan os.Signal, 1)
+		signal.Notify(reloadCh, syscall.SIGHUP)
+		go func() {
+			for range reloadCh {
+				if _, err := reloadServeConfig(srv, chain, serveConfigPath, cfg.Port); err != nil {
+					fmt.Fprintf(os.Stderr, "chop: config reload failed: %v\n", err)
+				}
+			}
+		}()
+
+		shutdownCh := make(chan os.Signal, 1)
+		signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			sig := <-shutdownCh
+			ctx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+			defer cancel()
+			if err := srv.Stop(ctx); err != nil {
+				fmt.Fprintf(os.Stderr, "chop: graceful shutdown after %s: %v\n", sig, err)
+			}
+		}()
+
+		srv.LogStart(serveAddr)
+		err = srv.Serve(serveAddr)
+		if err != nil {
+			srv.LogShutdown(err.Error())
+		} else {
+			srv.LogShutdown("graceful shutdown complete")
+		}
+		if serveDumpStatePath != "" {
+			if dumpErr := genesis.DumpState(chain, serveDumpStatePath, serveDumpStateStorage); dumpErr != nil {
+				fmt.Fprintf(os.Stderr, "chop: --dump-state: %v\n", dumpErr)
+			}
+		}
+		return err
+	},
+}
+
+// serveShutdownTimeout bounds how long Stop waits for in-flight
+// requests to drain on SIGINT/SIGTERM before force-closing.
+const serveShutdownTimeout = 5 * time.Second
+
+// reloadServeConfig re-reads the config file at configPath and applies
+// its hot-reloadable fields (Verbose, GasLimit, GasPrice, CorsOrigins,
+// LogFormat) to the live srv and chain, so `chop serve` can pick up
+// changes on SIGHUP without a restart. Port isn't reloadable, since
+// the server is already bound to its listener; if it changed,
+// reloadServeConfig logs a warning instead of silently ignoring it.
+func reloadServeConfig(srv *rpcserver.Server, chain *blockchain.Chain, configPath string, currentPort int) (config.Config, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return config.Config{}, err
+	}
+	srv.SetVerbose(cfg.Verbose)
+	srv.SetGasPrice(cfg.GasPrice)
+	srv.SetAllowedOrigins(cfg.CorsOrigins)
+	srv.SetLogFormat(cfg.LogFormat)
+	chain.SetGasLimit(cfg.GasLimit)
+	if cfg.Port != currentPort {
+		fmt.Fprintf(os.Stderr, "chop: config reload: port changed to %d, but a restart is required for it to take effect\n", cfg.Port)
+	}
+	return cfg, nil
+}
+
+// printAccounts prints the dev accounts manager funds on startup:
+// index, address, and balance for each, followed by the seed hex they
+// were derived from. Private keys are only printed when showKeys is
+// true, since they're sensitive even for throwaway dev accounts.
+func printAccounts(w io.Writer, manager *accounts.Manager, seedHex string, showKeys bool) {
+	fmt.Fprintln(w, "Available Accounts")
+	fmt.Fprintln(w, "==================")
+	for i, entry := range manager.GetAllAccounts() {
+		fmt.Fprintf(w, "(%d) 0x%x (%s ETH)\n", i, entry.Address, render.FormatBalance(entry.Account.Balance, 0))
+	}
+
+	if showKeys {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Private Keys")
+		fmt.Fprintln(w, "==================")
+		for i, entry := range manager.GetAllAccounts() {
+			if key, ok := manager.GetPrivateKey(entry.Address); ok {
+				fmt.Fprintf(w, "(%d) 0x%x\n", i, key)
+			}
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Seed: 0x%s\n", seedHex)
+	fmt.Fprintln(w)
+}
+
+// resolveChain looks up chain by numeric ID first, then short name
+// (e.g. "ronin"), so --chain accepts either.
+func resolveChain(chain string) (chains.Chain, bool) {
+	if id, err := strconv.ParseUint(chain, 10, 64); err == nil {
+		return chains.GetChainByID(id)
+	}
+	return chains.GetChainByShortName(chain)
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "127.0.0.1:8545", "address to listen on")
+	serveCmd.Flags().BoolVar(&serveShowKeys, "show-keys", false, "also print each dev account's private key on startup")
+	serveCmd.Flags().StringVar(&serveBinary, "evm-binary", "./guillotine/zig-out/bin/guillotine", "path to the built guillotine binary")
+	serveCmd.Flags().StringArrayVar(&serveCorsOrigins, "cors-origin", []string{"*"}, "allowed CORS origin (repeatable)")
+	serveCmd.Flags().IntVar(&serveMaxBatch, "max-batch-size", 100, "maximum number of requests allowed in a single JSON-RPC batch")
+	serveCmd.Flags().IntVar(&serveRateLimit, "rate-limit", 0, "maximum requests per second per client IP; 0 disables rate limiting")
+	serveCmd.Flags().StringVar(&serveAuthToken, "auth-token", "", "require \"Authorization: Bearer <token>\" on every JSON-RPC request; especially recommended when enableCheats is on and the server is network-exposed")
+	serveCmd.Flags().StringVar(&serveChain, "chain", "", "chain ID or short name (e.g. \"ronin\") to seed --chain-id and --fork-url from; explicit flags still override")
+	serveCmd.Flags().Uint64Var(&serveChainID, "chain-id", 1, "chain ID reported by eth_chainId and net_version")
+	serveCmd.Flags().StringVar(&serveForkURL, "fork-url", "", "JSON-RPC endpoint to fork state from; enables the chop_forkStats/chop_clearForkCache admin methods")
+	serveCmd.Flags().DurationVar(&serveBlockTime, "block-time", 0, "mine a new block automatically every interval (e.g. 5s); 0 disables auto-mining")
+	serveCmd.Flags().StringVar(&serveConfigPath, "config", "", "path to a config file, overriding $CHOP_CONFIG and the default location")
+	serveCmd.Flags().StringVar(&serveGenesisPath, "genesis", "", "anvil/hardhat-style genesis JSON to seed accounts, code, and storage from on startup")
+	serveCmd.Flags().StringVar(&serveDumpStatePath, "dump-state", "", "write all known accounts, code, and (with --dump-state-storage) storage to this path as genesis JSON on shutdown")
+	serveCmd.Flags().BoolVar(&serveDumpStateStorage, "dump-state-storage", false, "include contract storage in --dump-state output; can be large")
+	serveCmd.Flags().StringVar(&serveHistoryFile, "history-file", "", "persist call history as append-only JSONL at this path, surviving restarts, instead of keeping it in memory only")
+	rootCmd.AddCommand(serveCmd)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/rpcserver"
+)
+
+func TestPrintAccountsListsTenAddresses(t *testing.T) {
+	balance := new(big.Int).Mul(big.NewInt(10000), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	manager, err := accounts.NewManagerWithOptions(accounts.ManagerOptions{Count: 10, InitialBalanceWei: balance})
+	if err != nil {
+		t.Fatalf("NewManagerWithOptions: %v", err)
+	}
+
+	var buf bytes.Buffer
+	printAccounts(&buf, manager, accounts.DefaultSeedHex, false)
+
+	out := buf.String()
+	count := 0
+	for _, entry := range manager.GetAllAccounts() {
+		if strings.Contains(out, fmt.Sprintf("0x%x", entry.Address)) {
+			count++
+		}
+	}
+	if count != 10 {
+		t.Errorf("got %d addresses printed, want 10\noutput:\n%s", count, out)
+	}
+	if strings.Contains(out, "Private Keys") {
+		t.Error("got private keys printed without --show-keys")
+	}
+	if !strings.Contains(out, accounts.DefaultSeedHex) {
+		t.Error("expected the seed hex to be printed")
+	}
+}
+
+func TestPrintAccountsIncludesPrivateKeysWhenShown(t *testing.T) {
+	manager, err := accounts.NewManagerWithOptions(accounts.ManagerOptions{Count: 2, InitialBalanceWei: big.NewInt(0)})
+	if err != nil {
+		t.Fatalf("NewManagerWithOptions: %v", err)
+	}
+
+	var buf bytes.Buffer
+	printAccounts(&buf, manager, accounts.DefaultSeedHex, true)
+
+	if !strings.Contains(buf.String(), "Private Keys") {
+		t.Error("expected a Private Keys section when showKeys is true")
+	}
+}
+
+func TestResolveChainByShortName(t *testing.T) {
+	c, ok := resolveChain("ronin")
+	if !ok {
+		t.Fatal("want \"ronin\" to resolve")
+	}
+	if c.ID != 2020 {
+		t.Errorf("got chain ID %d, want 2020", c.ID)
+	}
+}
+
+func TestResolveChainByNumericID(t *testing.T) {
+	c, ok := resolveChain("2020")
+	if !ok {
+		t.Fatal("want \"2020\" to resolve")
+	}
+	if c.ShortName != "ronin" {
+		t.Errorf("got short name %q, want ronin", c.ShortName)
+	}
+}
+
+func TestResolveChainRejectsUnknownChain(t *testing.T) {
+	if _, ok := resolveChain("not-a-real-chain"); ok {
+		t.Error("want an unknown chain to fail to resolve")
+	}
+}
+
+func TestReloadServeConfigAppliesHotReloadableFields(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	body := `{"gasLimit": 5000000, "gasPrice": 42, "verbose": true, "corsOrigins": ["https://example.com"]}`
+	if err := os.WriteFile(configPath, []byte(body), 0o644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	srv := rpcserver.New()
+	chain := blockchain.New()
+
+	got, err := reloadServeConfig(srv, chain, configPath, 8545)
+	if err != nil {
+		t.Fatalf("reloadServeConfig: %v", err)
+	}
+	if got.GasLimit != 5_000_000 {
+		t.Errorf("got reloaded GasLimit %d, want 5000000", got.GasLimit)
+	}
+	if got.GasPrice != 42 {
+		t.Errorf("got reloaded GasPrice %d, want 42", got.GasPrice)
+	}
+	if !got.Verbose {
+		t.Error("got reloaded Verbose false, want true")
+	}
+	if chain.GetGasLimit() != 5_000_000 {
+		t.Errorf("got chain.GetGasLimit() %d, want 5000000", chain.GetGasLimit())
+	}
+}
+
+func TestReloadServeConfigWarnsOnPortChangeWithoutErroring(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"port": 9999}`), 0o644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	srv := rpcserver.New()
+	chain := blockchain.New()
+
+	got, err := reloadServeConfig(srv, chain, configPath, 8545)
+	if err != nil {
+		t.Fatalf("reloadServeConfig: %v", err)
+	}
+	if got.Port != 9999 {
+		t.Errorf("got reloaded Port %d, want 9999 (config is still loaded even though a restart is required)", got.Port)
+	}
+}
+
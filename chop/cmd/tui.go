@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fork"
+	"github.com/evmts/chop/internal/genesis"
+	"github.com/evmts/chop/internal/rpcserver"
+	"github.com/evmts/chop/internal/tui"
+)
+
+var (
+	tuiAddr        string
+	tuiBinary      string
+	tuiChain       string
+	tuiChainID     uint64
+	tuiForkURL     string
+	tuiGenesisPath string
+)
+
+// tuiCmd runs a dev chain and JSON-RPC server alongside RootModel's
+// Contracts/History/Fixtures/Settings dashboard, so activity sent to
+// the server from another terminal (eth_sendTransaction, eth_call)
+// shows up live instead of needing a separate `chop serve` plus a
+// second tool to inspect it.
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Launch chop's interactive terminal UI",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if tuiChain != "" {
+			resolved, ok := resolveChain(tuiChain)
+			if !ok {
+				return fmt.Errorf("--chain: %q is not a known chain ID or short name", tuiChain)
+			}
+			if !cmd.Flags().Changed("chain-id") {
+				tuiChainID = resolved.ID
+			}
+			if !cmd.Flags().Changed("fork-url") && resolved.RPCURL != "" {
+				tuiForkURL = resolved.RPCURL
+			}
+		}
+
+		chain := blockchain.New()
+		weiPerEther := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+		balanceWei := new(big.Int).Mul(big.NewInt(serveAccountBalanceEther), weiPerEther)
+		fundedAccounts, err := accounts.NewManagerWithOptions(accounts.ManagerOptions{
+			Count:             serveAccountCount,
+			InitialBalanceWei: balanceWei,
+		})
+		if err != nil {
+			return fmt.Errorf("funding dev accounts: %w", err)
+		}
+		chain.Accounts = fundedAccounts
+		if tuiGenesisPath != "" {
+			genesisAccounts, err := genesis.Load(tuiGenesisPath)
+			if err != nil {
+				return fmt.Errorf("--genesis: %w", err)
+			}
+			genesis.ApplyToChain(chain, genesisAccounts)
+		}
+
+		var forker *fork.Forker
+		if tuiForkURL != "" {
+			forker, err = fork.NewForker(fork.Config{URL: tuiForkURL})
+			if err != nil {
+				return err
+			}
+		}
+
+		srv := rpcserver.New()
+		if err := srv.SetChainID(tuiChainID); err != nil {
+			return err
+		}
+		rpcserver.RegisterChopMethods(srv, chain)
+		rpcserver.RegisterHealthCheck(srv, chain)
+		rpcserver.RegisterEthMethods(srv, chain, evm.NewEVM(tuiBinary))
+		if forker != nil {
+			rpcserver.RegisterForkMethods(srv, forker)
+		}
+
+		serveErrCh := make(chan error, 1)
+		go func() { serveErrCh <- srv.Serve(tuiAddr) }()
+
+		shutdownCh := make(chan os.Signal, 1)
+		signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			<-shutdownCh
+			ctx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+			defer cancel()
+			srv.Stop(ctx)
+		}()
+
+		root, err := tui.NewRootModel(chain, forker, nil, tuiChainID, 18)
+		if err != nil {
+			return err
+		}
+		_, runErr := tea.NewProgram(root).Run()
+
+		ctx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		if stopErr := srv.Stop(ctx); stopErr != nil && runErr == nil {
+			runErr = stopErr
+		}
+		<-serveErrCh
+		return runErr
+	},
+}
+
+func init() {
+	tuiCmd.Flags().StringVar(&tuiAddr, "addr", "127.0.0.1:8545", "address for the JSON-RPC server running alongside the TUI")
+	tuiCmd.Flags().StringVar(&tuiBinary, "evm-binary", "./guillotine/zig-out/bin/guillotine", "path to the built guillotine binary")
+	tuiCmd.Flags().StringVar(&tuiChain, "chain", "", "chain ID or short name (e.g. \"ronin\") to seed --chain-id and --fork-url from; explicit flags still override")
+	tuiCmd.Flags().Uint64Var(&tuiChainID, "chain-id", 1, "chain ID reported by eth_chainId and net_version")
+	tuiCmd.Flags().StringVar(&tuiForkURL, "fork-url", "", "JSON-RPC endpoint to fork state from; enables the Settings tab's fork cache stats")
+	tuiCmd.Flags().StringVar(&tuiGenesisPath, "genesis", "", "anvil/hardhat-style genesis JSON to seed accounts, code, and storage from on startup")
+	rootCmd.AddCommand(tuiCmd)
+}
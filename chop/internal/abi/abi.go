@@ -0,0 +1,81 @@
+// Package abi recognizes a small set of well-known Solidity event
+// signatures in emitted logs and decodes their indexed/data arguments,
+// so chop's output can show "Transfer(alice -> bob, 1000)" instead of
+// raw topics and hex.
+package abi
+
+import (
+	"math/big"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// TransferTopic is the topic[0] for ERC20's Transfer(address,address,uint256).
+var TransferTopic = types.Hash{
+	0xdd, 0xf2, 0x52, 0xad, 0x1b, 0xe2, 0xc8, 0x9b,
+	0x69, 0xc2, 0xb0, 0x68, 0xfc, 0x37, 0x8d, 0xaa,
+	0x95, 0x2b, 0xa7, 0xf1, 0x63, 0xc4, 0xa1, 0x16,
+	0x28, 0xf5, 0x5a, 0x4d, 0xf5, 0x23, 0xb3, 0xef,
+}
+
+// ApprovalTopic is the topic[0] for ERC20's Approval(address,address,uint256).
+var ApprovalTopic = types.Hash{
+	0x8c, 0x5b, 0xe1, 0xe5, 0xeb, 0xec, 0x7d, 0x5b,
+	0xd1, 0x4f, 0x71, 0x42, 0x7d, 0x1e, 0x84, 0xf3,
+	0xdd, 0x03, 0x14, 0xc0, 0xf7, 0xb2, 0x29, 0x1e,
+	0x5b, 0x20, 0x0a, 0xc8, 0xc7, 0xc3, 0xb9, 0x25,
+}
+
+// DecodedLog is a recognized event's arguments, decoded from a Log's
+// topics and data.
+type DecodedLog struct {
+	Event string
+	From  types.Address
+	To    types.Address
+	Value *big.Int
+}
+
+// DecodeLog recognizes log as an ERC20 Transfer or Approval event and
+// decodes its from/to/value arguments. It returns ok=false for any log
+// that doesn't match a known signature with the expected shape (three
+// topics, a 32-byte value word), rather than erroring — an
+// unrecognized event is routine, not exceptional.
+func DecodeLog(log types.Log) (decoded DecodedLog, ok bool) {
+	if len(log.Topics) != 3 || len(log.Data) < 32 {
+		return DecodedLog{}, false
+	}
+
+	switch log.Topics[0] {
+	case TransferTopic:
+		decoded.Event = "Transfer"
+	case ApprovalTopic:
+		decoded.Event = "Approval"
+	default:
+		return DecodedLog{}, false
+	}
+
+	decoded.From = addressFromTopic(log.Topics[1])
+	decoded.To = addressFromTopic(log.Topics[2])
+	decoded.Value = new(big.Int).SetBytes(log.Data[:32])
+	return decoded, true
+}
+
+// DecodeLogs decodes every log in logs that matches a known event,
+// skipping those that don't.
+func DecodeLogs(logs []types.Log) []DecodedLog {
+	var decoded []DecodedLog
+	for _, log := range logs {
+		if d, ok := DecodeLog(log); ok {
+			decoded = append(decoded, d)
+		}
+	}
+	return decoded
+}
+
+// addressFromTopic extracts the low 20 bytes of an indexed address
+// argument, which Solidity left-pads to a full 32-byte topic.
+func addressFromTopic(topic types.Hash) types.Address {
+	var addr types.Address
+	copy(addr[:], topic[12:])
+	return addr
+}
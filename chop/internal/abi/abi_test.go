@@ -0,0 +1,92 @@
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func addressTopic(addr types.Address) types.Hash {
+	var topic types.Hash
+	copy(topic[12:], addr[:])
+	return topic
+}
+
+func TestDecodeLogDecodesTransfer(t *testing.T) {
+	from := types.Address{0xaa}
+	to := types.Address{0xbb}
+	value := big.NewInt(1000)
+	data := make([]byte, 32)
+	value.FillBytes(data)
+
+	log := types.Log{
+		Topics: []types.Hash{TransferTopic, addressTopic(from), addressTopic(to)},
+		Data:   data,
+	}
+
+	decoded, ok := DecodeLog(log)
+	if !ok {
+		t.Fatal("expected Transfer to decode")
+	}
+	if decoded.Event != "Transfer" {
+		t.Errorf("got event %q, want Transfer", decoded.Event)
+	}
+	if decoded.From != from {
+		t.Errorf("got from %x, want %x", decoded.From, from)
+	}
+	if decoded.To != to {
+		t.Errorf("got to %x, want %x", decoded.To, to)
+	}
+	if decoded.Value.Cmp(value) != 0 {
+		t.Errorf("got value %s, want %s", decoded.Value, value)
+	}
+}
+
+func TestDecodeLogDecodesApproval(t *testing.T) {
+	log := types.Log{
+		Topics: []types.Hash{ApprovalTopic, addressTopic(types.Address{0x01}), addressTopic(types.Address{0x02})},
+		Data:   make([]byte, 32),
+	}
+
+	decoded, ok := DecodeLog(log)
+	if !ok || decoded.Event != "Approval" {
+		t.Fatalf("expected Approval to decode, got %+v, ok=%v", decoded, ok)
+	}
+}
+
+func TestDecodeLogIgnoresUnknownSignatures(t *testing.T) {
+	log := types.Log{
+		Topics: []types.Hash{{0x01}, addressTopic(types.Address{0x01}), addressTopic(types.Address{0x02})},
+		Data:   make([]byte, 32),
+	}
+
+	if _, ok := DecodeLog(log); ok {
+		t.Error("expected an unrecognized topic to not decode")
+	}
+}
+
+func TestDecodeLogIgnoresMalformedLogs(t *testing.T) {
+	cases := []types.Log{
+		{Topics: []types.Hash{TransferTopic}, Data: make([]byte, 32)},
+		{Topics: []types.Hash{TransferTopic, {}, {}}, Data: []byte{0x01}},
+	}
+	for i, log := range cases {
+		if _, ok := DecodeLog(log); ok {
+			t.Errorf("case %d: expected malformed log to not decode", i)
+		}
+	}
+}
+
+func TestDecodeLogsSkipsUnrecognizedAndKeepsRecognized(t *testing.T) {
+	transfer := types.Log{
+		Topics: []types.Hash{TransferTopic, addressTopic(types.Address{0x01}), addressTopic(types.Address{0x02})},
+		Data:   make([]byte, 32),
+	}
+	unknown := types.Log{Topics: []types.Hash{{0xff}}}
+
+	decoded := DecodeLogs([]types.Log{transfer, unknown})
+	if len(decoded) != 1 || decoded[0].Event != "Transfer" {
+		t.Errorf("got %+v, want a single decoded Transfer", decoded)
+	}
+}
@@ -0,0 +1,147 @@
+package abi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decode is the inverse of Encode: given the Solidity types a call
+// returns (no function name or parens, just the comma-less type list,
+// e.g. []string{"bool", "uint256"}) and the raw return data, it
+// decodes each value to a display string — decimal for integers,
+// "0x..." for addresses/bytes, the literal text for strings, and
+// "[v1,v2,...]" for arrays.
+func Decode(types []string, data []byte) ([]string, error) {
+	out := make([]string, len(types))
+	for i, t := range types {
+		headStart := i * wordSize
+		if headStart+wordSize > len(data) {
+			return nil, fmt.Errorf("abi: argument %d (%s): truncated return data", i+1, t)
+		}
+		head := data[headStart : headStart+wordSize]
+
+		val, err := decodeParam(t, head, data)
+		if err != nil {
+			return nil, fmt.Errorf("abi: argument %d (%s): %w", i+1, t, err)
+		}
+		out[i] = val
+	}
+	return out, nil
+}
+
+// decodeParam decodes a single value of type t. head is t's 32-byte
+// word within the head section; data is the full return data, needed
+// to resolve a dynamic type's offset.
+func decodeParam(t string, head, data []byte) (string, error) {
+	if base, ok := strings.CutSuffix(t, "[]"); ok {
+		return decodeArray(base, head, data)
+	}
+
+	switch {
+	case t == "address":
+		return fmt.Sprintf("0x%x", head[12:32]), nil
+	case t == "bool":
+		return strconv.FormatBool(!isZero(head)), nil
+	case t == "bytes":
+		b, err := dynamicBytes(head, data)
+		if err != nil {
+			return "", err
+		}
+		return "0x" + hex.EncodeToString(b), nil
+	case t == "string":
+		b, err := dynamicBytes(head, data)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case strings.HasPrefix(t, "uint"):
+		if _, err := parseBits(t, "uint"); err != nil {
+			return "", err
+		}
+		return new(big.Int).SetBytes(head).String(), nil
+	case strings.HasPrefix(t, "bytes"):
+		n, err := strconv.Atoi(t[len("bytes"):])
+		if err != nil || n < 1 || n > 32 {
+			return "", fmt.Errorf("unsupported type %q", t)
+		}
+		return "0x" + hex.EncodeToString(head[:n]), nil
+	default:
+		return "", fmt.Errorf("unsupported type %q", t)
+	}
+}
+
+// decodeArray decodes a dynamic T[] argument: head holds the offset
+// to a length word, followed by that many T elements. T must be a
+// static type; arrays of dynamic types aren't supported, mirroring
+// Encode's limitation.
+func decodeArray(base string, head, data []byte) (string, error) {
+	offset, ok := decodeOffsetOrLength(head)
+	if !ok || offset+wordSize > uint64(len(data)) {
+		return "", fmt.Errorf("malformed array offset")
+	}
+	length, ok := decodeOffsetOrLength(data[offset : offset+wordSize])
+	if !ok {
+		return "", fmt.Errorf("malformed array length")
+	}
+
+	elems := make([]string, length)
+	for i := uint64(0); i < length; i++ {
+		start := offset + wordSize + i*wordSize
+		if start+wordSize > uint64(len(data)) {
+			return "", fmt.Errorf("truncated array element %d", i)
+		}
+		elemHead := data[start : start+wordSize]
+		val, err := decodeParam(base, elemHead, data)
+		if err != nil {
+			return "", fmt.Errorf("element %d: %w", i, err)
+		}
+		elems[i] = val
+	}
+	return "[" + strings.Join(elems, ",") + "]", nil
+}
+
+// dynamicBytes resolves a bytes/string head's offset, reads its
+// length word, and returns the raw bytes that follow.
+func dynamicBytes(head, data []byte) ([]byte, error) {
+	offset, ok := decodeOffsetOrLength(head)
+	if !ok || offset+wordSize > uint64(len(data)) {
+		return nil, fmt.Errorf("malformed offset")
+	}
+	length, ok := decodeOffsetOrLength(data[offset : offset+wordSize])
+	if !ok {
+		return nil, fmt.Errorf("malformed length")
+	}
+	start := offset + wordSize
+	end := start + length
+	if end > uint64(len(data)) {
+		return nil, fmt.Errorf("truncated data")
+	}
+	return data[start:end], nil
+}
+
+// decodeOffsetOrLength reads a 32-byte big-endian ABI word as a
+// uint64, rejecting words whose value doesn't fit — for an
+// offset/length that always indicates malformed data rather than a
+// legitimately huge value.
+func decodeOffsetOrLength(word []byte) (uint64, bool) {
+	for _, b := range word[:24] {
+		if b != 0 {
+			return 0, false
+		}
+	}
+	return binary.BigEndian.Uint64(word[24:32]), true
+}
+
+// isZero reports whether every byte in word is zero.
+func isZero(word []byte) bool {
+	for _, b := range word {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
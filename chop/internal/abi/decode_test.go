@@ -0,0 +1,80 @@
+package abi
+
+import "testing"
+
+func TestDecodeUint256(t *testing.T) {
+	data := abiWord(1000)
+
+	got, err := Decode([]string{"uint256"}, data)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(got) != 1 || got[0] != "1000" {
+		t.Errorf("got %v, want [\"1000\"]", got)
+	}
+}
+
+func TestDecodeBoolUint256Tuple(t *testing.T) {
+	var data []byte
+	data = append(data, abiWord(1)...) // true
+	data = append(data, abiWord(42)...)
+
+	got, err := Decode([]string{"bool", "uint256"}, data)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(got) != 2 || got[0] != "true" || got[1] != "42" {
+		t.Errorf("got %v, want [\"true\" \"42\"]", got)
+	}
+}
+
+func TestDecodeString(t *testing.T) {
+	data := mustEncode(t, "f(string)", []string{"chop"})
+
+	got, err := Decode([]string{"string"}, data[4:])
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(got) != 1 || got[0] != "chop" {
+		t.Errorf("got %v, want [\"chop\"]", got)
+	}
+}
+
+func TestDecodeUintArray(t *testing.T) {
+	data := mustEncode(t, "f(uint256[])", []string{"[7,8,9]"})
+
+	got, err := Decode([]string{"uint256[]"}, data[4:])
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(got) != 1 || got[0] != "[7,8,9]" {
+		t.Errorf("got %v, want [\"[7,8,9]\"]", got)
+	}
+}
+
+func TestDecodeRejectsTruncatedData(t *testing.T) {
+	if _, err := Decode([]string{"uint256", "bool"}, abiWord(1)); err == nil {
+		t.Error("expected an error for data with only one of two words")
+	}
+}
+
+// abiWord encodes n as a single 32-byte big-endian ABI word, for
+// building raw return data directly in tests.
+func abiWord(n uint64) []byte {
+	word := make([]byte, 32)
+	for i := 0; i < 8; i++ {
+		word[31-i] = byte(n >> (8 * i))
+	}
+	return word
+}
+
+// mustEncode calls Encode to build calldata, for reuse as a source of
+// known-good dynamic return data in Decode tests.
+func mustEncode(t *testing.T, signature string, args []string) []byte {
+	t.Helper()
+	data, err := Encode(signature, args)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	return data
+}
@@ -0,0 +1,282 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// wordSize is the ABI word size: every static value, and every
+// dynamic type's offset or length, occupies one 32-byte word.
+const wordSize = 32
+
+// Encode ABI-encodes a call to signature (e.g.
+// "transfer(address,uint256)") with args given as one decimal/hex/
+// text string per parameter, in order, and returns the 4-byte
+// selector followed by the encoded arguments.
+//
+// Supported types: address, bool, uintN (uint256 if N is omitted),
+// bytesN, bytes, string, and T[] dynamic arrays of any static T
+// (address, bool, uintN, bytesN) with args given as a
+// comma-separated list, optionally wrapped in [brackets].
+func Encode(signature string, args []string) ([]byte, error) {
+	name, types, err := parseSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) != len(args) {
+		return nil, fmt.Errorf("abi: %s expects %d argument(s), got %d", name, len(types), len(args))
+	}
+
+	type param struct {
+		dynamic bool
+		data    []byte
+	}
+	params := make([]param, len(types))
+	for i, t := range types {
+		dynamic, data, err := encodeParam(t, args[i])
+		if err != nil {
+			return nil, fmt.Errorf("abi: argument %d (%s): %w", i+1, t, err)
+		}
+		params[i] = param{dynamic, data}
+	}
+
+	out := selector(signature)
+	offset := len(types) * wordSize
+	for _, p := range params {
+		if p.dynamic {
+			out = append(out, encodeUint(big.NewInt(int64(offset)))...)
+			offset += len(p.data)
+		} else {
+			out = append(out, p.data...)
+		}
+	}
+	for _, p := range params {
+		if p.dynamic {
+			out = append(out, p.data...)
+		}
+	}
+	return out, nil
+}
+
+// selector returns the 4-byte Keccak256 function selector for signature.
+func selector(signature string) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte(signature))
+	return h.Sum(nil)[:4]
+}
+
+// parseSignature splits "name(type,type,...)" into the function name
+// and its parameter types.
+func parseSignature(signature string) (name string, types []string, err error) {
+	open := strings.IndexByte(signature, '(')
+	if open < 0 || !strings.HasSuffix(signature, ")") {
+		return "", nil, fmt.Errorf("abi: malformed signature %q, want name(type,type,...)", signature)
+	}
+	name = signature[:open]
+	body := signature[open+1 : len(signature)-1]
+	if body == "" {
+		return name, nil, nil
+	}
+	for _, t := range strings.Split(body, ",") {
+		types = append(types, strings.TrimSpace(t))
+	}
+	return name, types, nil
+}
+
+// encodeParam encodes a single argument of Solidity type t, returning
+// whether it's a dynamic type (so Encode offsets rather than inlines
+// it) and its head (static) or tail (dynamic) bytes.
+func encodeParam(t, arg string) (dynamic bool, data []byte, err error) {
+	if base, ok := strings.CutSuffix(t, "[]"); ok {
+		return encodeArray(base, arg)
+	}
+
+	switch {
+	case t == "address":
+		data, err := encodeAddress(arg)
+		return false, data, err
+	case t == "bool":
+		data, err := encodeBool(arg)
+		return false, data, err
+	case t == "bytes":
+		b, err := decodeHex(arg)
+		if err != nil {
+			return false, nil, err
+		}
+		return true, encodeBytesDynamic(b), nil
+	case t == "string":
+		return true, encodeBytesDynamic([]byte(arg)), nil
+	case strings.HasPrefix(t, "uint"):
+		bits, err := parseBits(t, "uint")
+		if err != nil {
+			return false, nil, err
+		}
+		data, err := encodeUintArg(arg, bits)
+		return false, data, err
+	case strings.HasPrefix(t, "bytes"):
+		n, err := strconv.Atoi(t[len("bytes"):])
+		if err != nil || n < 1 || n > 32 {
+			return false, nil, fmt.Errorf("abi: unsupported type %q", t)
+		}
+		data, err := encodeBytesFixed(arg, n)
+		return false, data, err
+	default:
+		return false, nil, fmt.Errorf("abi: unsupported type %q", t)
+	}
+}
+
+// encodeArray encodes a T[] argument, given as a comma-separated list
+// of elements optionally wrapped in [brackets]. T must be a static
+// type; arrays of dynamic types (string[], bytes[], T[][]) aren't
+// supported.
+func encodeArray(base, arg string) (dynamic bool, data []byte, err error) {
+	items := splitArrayArgs(arg)
+	data = append(data, encodeUint(big.NewInt(int64(len(items))))...)
+	for _, item := range items {
+		elemDynamic, elemData, err := encodeParam(base, item)
+		if err != nil {
+			return false, nil, err
+		}
+		if elemDynamic {
+			return false, nil, fmt.Errorf("abi: arrays of dynamic type %q are not supported", base)
+		}
+		data = append(data, elemData...)
+	}
+	return true, data, nil
+}
+
+// splitArrayArgs splits a "[a,b,c]" or "a,b,c" argument into its
+// elements, trimming whitespace. An empty (or "[]") argument yields
+// no elements.
+func splitArrayArgs(arg string) []string {
+	arg = strings.TrimSpace(arg)
+	arg = strings.TrimPrefix(arg, "[")
+	arg = strings.TrimSuffix(arg, "]")
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return nil
+	}
+	parts := strings.Split(arg, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
+
+// parseBits extracts the bit width from a type like "uint256",
+// defaulting to 256 when the width is omitted ("uint"). It rejects
+// widths that aren't a multiple of 8 up to 256.
+func parseBits(t, prefix string) (int, error) {
+	suffix := strings.TrimPrefix(t, prefix)
+	if suffix == "" {
+		return 256, nil
+	}
+	bits, err := strconv.Atoi(suffix)
+	if err != nil || bits <= 0 || bits > 256 || bits%8 != 0 {
+		return 0, fmt.Errorf("abi: unsupported type %q", t)
+	}
+	return bits, nil
+}
+
+// encodeAddress left-pads a 20-byte hex address (with or without a
+// "0x" prefix) to a 32-byte word.
+func encodeAddress(arg string) ([]byte, error) {
+	b, err := decodeHex(arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 20 {
+		return nil, fmt.Errorf("want a 20-byte address, got %d bytes", len(b))
+	}
+	return leftPad32(b), nil
+}
+
+// encodeBool encodes "true"/"false" as a 32-byte word holding 0 or 1.
+func encodeBool(arg string) ([]byte, error) {
+	v, err := strconv.ParseBool(arg)
+	if err != nil {
+		return nil, fmt.Errorf("want true or false, got %q", arg)
+	}
+	if v {
+		return encodeUint(big.NewInt(1)), nil
+	}
+	return encodeUint(big.NewInt(0)), nil
+}
+
+// encodeUintArg parses a decimal (or 0x-prefixed hex) non-negative
+// integer and encodes it as a 32-byte word, rejecting values that
+// overflow bits.
+func encodeUintArg(arg string, bits int) ([]byte, error) {
+	n, ok := new(big.Int).SetString(arg, 0)
+	if !ok || n.Sign() < 0 {
+		return nil, fmt.Errorf("want a non-negative integer, got %q", arg)
+	}
+	if n.BitLen() > bits {
+		return nil, fmt.Errorf("%s overflows uint%d", arg, bits)
+	}
+	return encodeUint(n), nil
+}
+
+// encodeUint encodes a non-negative integer as a 32-byte big-endian word.
+func encodeUint(n *big.Int) []byte {
+	return leftPad32(n.Bytes())
+}
+
+// encodeBytesFixed right-pads a hex-encoded bytesN value to a 32-byte
+// word, as bytesN values are left-aligned within their word.
+func encodeBytesFixed(arg string, n int) ([]byte, error) {
+	b, err := decodeHex(arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, fmt.Errorf("want %d bytes, got %d", n, len(b))
+	}
+	return rightPad32(b), nil
+}
+
+// encodeBytesDynamic encodes a length-prefixed, word-padded dynamic
+// bytes/string value: one word for the length, then the data
+// right-padded to a whole number of words.
+func encodeBytesDynamic(b []byte) []byte {
+	out := encodeUint(big.NewInt(int64(len(b))))
+	return append(out, rightPad32(b)...)
+}
+
+// decodeHex decodes a hex string, tolerating an optional "0x" prefix.
+func decodeHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(s, "0x")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("want hex, got %q: %w", s, err)
+	}
+	return b, nil
+}
+
+// leftPad32 zero-pads b on the left to a 32-byte word, as used for
+// right-aligned values (addresses, integers, bools).
+func leftPad32(b []byte) []byte {
+	if len(b) >= wordSize {
+		return b[len(b)-wordSize:]
+	}
+	out := make([]byte, wordSize)
+	copy(out[wordSize-len(b):], b)
+	return out
+}
+
+// rightPad32 zero-pads b on the right to a whole number of 32-byte
+// words, as used for left-aligned values (fixed and dynamic bytes).
+func rightPad32(b []byte) []byte {
+	padded := ((len(b) + wordSize - 1) / wordSize) * wordSize
+	if padded == 0 {
+		padded = wordSize
+	}
+	out := make([]byte, padded)
+	copy(out, b)
+	return out
+}
@@ -0,0 +1,88 @@
+package abi
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestEncodeTransferGoldenCalldata(t *testing.T) {
+	got, err := Encode("transfer(address,uint256)", []string{
+		"0x00000000000000000000000000000000000000aa",
+		"1000",
+	})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	want := "a9059cbb00000000000000000000000000000000000000000000000000000000000000aa" +
+		"00000000000000000000000000000000000000000000000000000000000003e8"
+	if got := hex.EncodeToString(got); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestEncodeBoolAndFixedBytes(t *testing.T) {
+	got, err := Encode("setFlag(bool,bytes4)", []string{"true", "0xdeadbeef"})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	want := selectorHex("setFlag(bool,bytes4)") +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"deadbeef00000000000000000000000000000000000000000000000000000000"
+	if got := hex.EncodeToString(got); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestEncodeDynamicString(t *testing.T) {
+	got, err := Encode("setName(string)", []string{"chop"})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	want := selectorHex("setName(string)") +
+		"0000000000000000000000000000000000000000000000000000000000000020" + // offset
+		"0000000000000000000000000000000000000000000000000000000000000004" + // length
+		"63686f7000000000000000000000000000000000000000000000000000000000" // "chop" padded
+	if got := hex.EncodeToString(got); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestEncodeUintArray(t *testing.T) {
+	got, err := Encode("sum(uint256[])", []string{"[1,2,3]"})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	want := selectorHex("sum(uint256[])") +
+		"0000000000000000000000000000000000000000000000000000000000000020" + // offset
+		"0000000000000000000000000000000000000000000000000000000000000003" + // length
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000003"
+	if got := hex.EncodeToString(got); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestEncodeRejectsArgumentCountMismatch(t *testing.T) {
+	if _, err := Encode("transfer(address,uint256)", []string{"0x00"}); err == nil {
+		t.Error("expected an error for a missing argument")
+	}
+}
+
+func TestEncodeRejectsOverflowingUint(t *testing.T) {
+	if _, err := Encode("approve(address,uint8)", []string{"0x00000000000000000000000000000000000000aa", "9000"}); err == nil {
+		t.Error("expected an error for a uint8 value that overflows 8 bits")
+	}
+}
+
+func TestEncodeRejectsUnsupportedType(t *testing.T) {
+	if _, err := Encode("callIt(tuple)", []string{"1"}); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
+
+// selectorHex returns the hex-encoded 4-byte function selector for
+// signature, for building expected golden calldata in tests.
+func selectorHex(signature string) string {
+	return hex.EncodeToString(selector(signature))
+}
@@ -0,0 +1,52 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Function is a single function entry parsed from a contract's ABI JSON.
+type Function struct {
+	Name   string
+	Inputs []string // Solidity type of each parameter, in declaration order
+}
+
+// Signature returns fn's canonical signature, e.g.
+// "transfer(address,uint256)", as expected by Encode.
+func (fn Function) Signature() string {
+	return fmt.Sprintf("%s(%s)", fn.Name, strings.Join(fn.Inputs, ","))
+}
+
+// abiEntry is a single element of a contract's ABI JSON array; only
+// the fields needed to build a callable signature are kept.
+type abiEntry struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Inputs []struct {
+		Type string `json:"type"`
+	} `json:"inputs"`
+}
+
+// ParseFunctions extracts every "function"-typed entry from a
+// contract's ABI JSON (the standard Solidity compiler output shape),
+// in declaration order. Constructors, events, and errors are skipped.
+func ParseFunctions(data []byte) ([]Function, error) {
+	var entries []abiEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("abi: parsing ABI JSON: %w", err)
+	}
+
+	var functions []Function
+	for _, e := range entries {
+		if e.Type != "function" {
+			continue
+		}
+		fn := Function{Name: e.Name}
+		for _, in := range e.Inputs {
+			fn.Inputs = append(fn.Inputs, in.Type)
+		}
+		functions = append(functions, fn)
+	}
+	return functions, nil
+}
@@ -0,0 +1,372 @@
+// Package accounts tracks the balances and nonces of accounts known
+// to a chop session's in-memory chain.
+package accounts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// importedIndex marks an Account that was brought in via
+// ImportPrivateKey rather than generated from the manager's seed.
+const importedIndex = -1
+
+// Account is a single account's balance and nonce. Index identifies
+// the account's position among seed-generated accounts, or
+// importedIndex if it was added with ImportPrivateKey.
+//
+// Balance is a *big.Int because account balances are denominated in
+// wei: 100 ETH alone already overflows a uint64. GetAccount and
+// GetAllAccounts return a deep copy of Balance so a caller mutating
+// the returned Account can't corrupt the manager's internal state.
+type Account struct {
+	Balance *big.Int
+	Nonce   uint64
+	Index   int
+}
+
+// AccountEntry pairs an address with its Account state, as returned
+// by GetAllAccounts.
+type AccountEntry struct {
+	Address types.Address
+	Account Account
+}
+
+// Manager is a thread-safe registry of accounts. The zero value for
+// an address not yet seen is an Account with a zero Balance and Nonce,
+// matching how an unfunded account reads on a real chain.
+type Manager struct {
+	mu          sync.RWMutex
+	accounts    map[types.Address]Account
+	privateKeys map[types.Address][]byte
+}
+
+// NewManager returns an empty Manager. blockchain.Chain and the RPC
+// server both depend on a fresh Manager starting unfunded (an unseen
+// address reads as a zero balance); use NewManagerWithOptions to get
+// a Manager pre-populated with generated, pre-funded accounts.
+func NewManager() *Manager {
+	return &Manager{
+		accounts:    make(map[types.Address]Account),
+		privateKeys: make(map[types.Address][]byte),
+	}
+}
+
+// ManagerOptions configures NewManagerWithOptions.
+type ManagerOptions struct {
+	// Count is how many accounts to generate. Must be > 0.
+	Count int
+	// InitialBalanceWei is the balance (in wei) each generated account
+	// starts with. Must not be negative.
+	InitialBalanceWei *big.Int
+	// SeedHex deterministically derives the generated accounts' keys.
+	// Two Managers built with the same SeedHex and Count generate the
+	// same addresses. Empty uses a fixed default seed.
+	SeedHex string
+}
+
+// DefaultSeedHex is used when ManagerOptions.SeedHex is empty, so
+// NewManagerWithOptions's default accounts are reproducible. It's
+// exported so callers that print the seed alongside the accounts it
+// derives (e.g. `chop serve`'s startup banner) don't have to hardcode
+// it themselves.
+const DefaultSeedHex = "63686f70206465666175756c74207365656400000000000000000000000000"
+
+// NewManagerWithOptions returns a Manager pre-populated with
+// opts.Count accounts, each generated deterministically from
+// opts.SeedHex and funded with opts.InitialBalanceWei. Generated
+// accounts are numbered by their Index (0, 1, 2, ...), distinguishing
+// them from accounts later added with ImportPrivateKey.
+func NewManagerWithOptions(opts ManagerOptions) (*Manager, error) {
+	if opts.Count <= 0 {
+		return nil, fmt.Errorf("accounts: Count must be > 0, got %d", opts.Count)
+	}
+	if opts.InitialBalanceWei == nil || opts.InitialBalanceWei.Sign() < 0 {
+		return nil, fmt.Errorf("accounts: InitialBalanceWei must not be negative")
+	}
+	seedHex := opts.SeedHex
+	if seedHex == "" {
+		seedHex = DefaultSeedHex
+	}
+	seed, err := hex.DecodeString(seedHex)
+	if err != nil {
+		return nil, fmt.Errorf("accounts: invalid SeedHex: %w", err)
+	}
+
+	m := NewManager()
+	for i := 0; i < opts.Count; i++ {
+		privateKey := derivePrivateKey(seed, i)
+		if _, err := m.importRawKey(privateKey, i); err != nil {
+			return nil, fmt.Errorf("accounts: generating account %d: %w", i, err)
+		}
+		m.SetBalance(deriveAddress(privateKey), opts.InitialBalanceWei)
+	}
+	return m, nil
+}
+
+// derivePrivateKey deterministically derives the i-th generated
+// account's placeholder private key from seed. See ImportPrivateKey's
+// doc comment for why address/key derivation here is a sha256-based
+// placeholder rather than real secp256k1.
+func derivePrivateKey(seed []byte, i int) []byte {
+	digest := sha256.Sum256(append(append([]byte{}, seed...), byte(i>>24), byte(i>>16), byte(i>>8), byte(i)))
+	return digest[:]
+}
+
+// GetTotalBalance sums the balance of every account the manager has
+// seen.
+func (m *Manager) GetTotalBalance() *big.Int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	total := big.NewInt(0)
+	for _, a := range m.accounts {
+		if a.Balance != nil {
+			total.Add(total, a.Balance)
+		}
+	}
+	return total
+}
+
+// zeroAccount returns the reading for an address the manager has
+// never seen: a freshly allocated zero Balance, not a shared one.
+func zeroAccount() Account {
+	return Account{Balance: big.NewInt(0)}
+}
+
+// copyAccount deep-copies a so the caller can't mutate the manager's
+// internal state through the returned value's Balance pointer.
+func copyAccount(a Account) Account {
+	balance := big.NewInt(0)
+	if a.Balance != nil {
+		balance.Set(a.Balance)
+	}
+	return Account{Balance: balance, Nonce: a.Nonce, Index: a.Index}
+}
+
+// GetAccount returns addr's current account state, or the zero
+// Account if it has never been seen. The returned Account is a deep
+// copy; mutating its Balance does not affect the manager.
+func (m *Manager) GetAccount(addr types.Address) Account {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	a, ok := m.accounts[addr]
+	if !ok {
+		return zeroAccount()
+	}
+	return copyAccount(a)
+}
+
+// GetPrivateKey returns addr's private key (hex-free raw bytes) and
+// true, or nil and false if the manager holds no key for addr (it was
+// only ever funded via SetBalance/ApplyStateDiff, not generated or
+// imported).
+func (m *Manager) GetPrivateKey(addr types.Address) ([]byte, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, ok := m.privateKeys[addr]
+	if !ok {
+		return nil, false
+	}
+	cp := make([]byte, len(key))
+	copy(cp, key)
+	return cp, true
+}
+
+// GetAllAccounts returns every account the manager has seen, sorted
+// by address, as deep copies.
+func (m *Manager) GetAllAccounts() []AccountEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := make([]AccountEntry, 0, len(m.accounts))
+	for addr, a := range m.accounts {
+		entries = append(entries, AccountEntry{Address: addr, Account: copyAccount(a)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return addrKey(entries[i].Address) < addrKey(entries[j].Address)
+	})
+	return entries
+}
+
+// addrKey renders an address as a string for ordering purposes only.
+func addrKey(addr types.Address) string {
+	return string(addr[:])
+}
+
+// SetBalance sets addr's balance, creating the account if needed. The
+// manager stores its own copy of balance, not the pointer passed in.
+func (m *Manager) SetBalance(addr types.Address, balance *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a, ok := m.accounts[addr]
+	if !ok {
+		a = zeroAccount()
+	}
+	a.Balance = new(big.Int).Set(balance)
+	m.accounts[addr] = a
+}
+
+// SetNonce sets addr's nonce, creating the account if needed. Used by
+// callers seeding an exact starting nonce (e.g. genesis import), as
+// opposed to IncrementNonce's per-transaction bump.
+func (m *Manager) SetNonce(addr types.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a, ok := m.accounts[addr]
+	if !ok {
+		a = zeroAccount()
+	}
+	a.Nonce = nonce
+	m.accounts[addr] = a
+}
+
+// IncrementNonce increments addr's nonce by one and returns the new
+// value.
+func (m *Manager) IncrementNonce(addr types.Address) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a, ok := m.accounts[addr]
+	if !ok {
+		a = zeroAccount()
+	}
+	a.Nonce++
+	m.accounts[addr] = a
+	return a.Nonce
+}
+
+// Snapshot is a point-in-time copy of a Manager's accounts and private
+// keys, as returned by Manager.Snapshot. It's opaque to callers other
+// than blockchain.Chain, which pairs it with its own block/tx state to
+// implement evm_snapshot/evm_revert-style reverts.
+type Snapshot struct {
+	accounts    map[types.Address]Account
+	privateKeys map[types.Address][]byte
+}
+
+// Snapshot captures a deep copy of every account and private key
+// currently known to m.
+func (m *Manager) Snapshot() Snapshot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	accounts := make(map[types.Address]Account, len(m.accounts))
+	for addr, a := range m.accounts {
+		accounts[addr] = copyAccount(a)
+	}
+	privateKeys := make(map[types.Address][]byte, len(m.privateKeys))
+	for addr, key := range m.privateKeys {
+		cp := make([]byte, len(key))
+		copy(cp, key)
+		privateKeys[addr] = cp
+	}
+	return Snapshot{accounts: accounts, privateKeys: privateKeys}
+}
+
+// Restore replaces m's entire account and private-key state with a
+// copy of snap, discarding anything recorded since snap was taken.
+func (m *Manager) Restore(snap Snapshot) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	accounts := make(map[types.Address]Account, len(snap.accounts))
+	for addr, a := range snap.accounts {
+		accounts[addr] = copyAccount(a)
+	}
+	privateKeys := make(map[types.Address][]byte, len(snap.privateKeys))
+	for addr, key := range snap.privateKeys {
+		cp := make([]byte, len(key))
+		copy(cp, key)
+		privateKeys[addr] = cp
+	}
+	m.accounts = accounts
+	m.privateKeys = privateKeys
+}
+
+// StateDiff batches per-address balance and nonce changes to apply
+// atomically via ApplyStateDiff. Contract code is deliberately not a
+// StateDiff field: code lives in blockchain.Chain's own code map, not
+// accounts.Manager, so a caller folding in a call's code changes
+// applies them via chain.SetCode alongside ApplyStateDiff rather than
+// through this type (see eth_sendTransaction).
+type StateDiff struct {
+	// BalanceDeltas adds each value (which may be negative) to the
+	// address's current balance.
+	BalanceDeltas map[types.Address]*big.Int
+	// NonceDeltas adds each value to the address's current nonce.
+	NonceDeltas map[types.Address]uint64
+}
+
+// ApplyStateDiff applies every balance and nonce change in diff under
+// a single write lock. If any balance delta would take an address
+// negative, no change in diff is applied and ApplyStateDiff returns
+// an error describing the offending address.
+func (m *Manager) ApplyStateDiff(diff StateDiff) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	newBalances := make(map[types.Address]*big.Int, len(diff.BalanceDeltas))
+	for addr, delta := range diff.BalanceDeltas {
+		current := m.accounts[addr].Balance
+		if current == nil {
+			current = big.NewInt(0)
+		}
+		result := new(big.Int).Add(current, delta)
+		if result.Sign() < 0 {
+			return fmt.Errorf("accounts: state diff would leave %x with a negative balance", addr)
+		}
+		newBalances[addr] = result
+	}
+
+	for addr, balance := range newBalances {
+		a, ok := m.accounts[addr]
+		if !ok {
+			a = zeroAccount()
+		}
+		a.Balance = balance
+		m.accounts[addr] = a
+	}
+	for addr, delta := range diff.NonceDeltas {
+		a, ok := m.accounts[addr]
+		if !ok {
+			a = zeroAccount()
+		}
+		a.Nonce += delta
+		m.accounts[addr] = a
+	}
+	return nil
+}
+
+// ImportPrivateKey brings an externally-generated private key (hex
+// encoded, with or without a "0x" prefix) into the manager, adding it
+// with zero balance and nonce and returning the new Account. It
+// rejects keys of the wrong length, non-hex input, and keys already
+// imported.
+//
+// The derived address is not yet real secp256k1 key derivation (chop
+// has no elliptic-curve dependency); it's a sha256-based placeholder,
+// same as blockchain.TransactionHash's placeholder for keccak256,
+// until a real crypto dependency is wired in.
+func (m *Manager) ImportPrivateKey(hexKey string) (Account, error) {
+	key := strings.TrimPrefix(hexKey, "0x")
+	raw, err := hex.DecodeString(key)
+	if err != nil {
+		return Account{}, fmt.Errorf("accounts: invalid private key hex: %w", err)
+	}
+	return m.importRawKey(raw, importedIndex)
+}
+
+// deriveAddress computes a placeholder address for a raw private key.
+// See ImportPrivateKey's doc comment for why this isn't real
+// secp256k1-based derivation.
+func deriveAddress(privateKey []byte) types.Address {
+	digest := sha256.Sum256(privateKey)
+	var addr types.Address
+	copy(addr[:], digest[len(digest)-len(addr):])
+	return addr
+}
@@ -0,0 +1,296 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestGetUnknownAddressIsZero(t *testing.T) {
+	m := NewManager()
+	got := m.GetAccount(types.Address{0x01})
+	if got.Balance.Sign() != 0 || got.Nonce != 0 {
+		t.Errorf("got %+v, want a zero balance and nonce", got)
+	}
+}
+
+func TestSetBalanceAndIncrementNonce(t *testing.T) {
+	m := NewManager()
+	addr := types.Address{0x01}
+
+	m.SetBalance(addr, big.NewInt(1000))
+	if got := m.GetAccount(addr).Balance; got.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("got balance %s, want 1000", got)
+	}
+
+	if got := m.IncrementNonce(addr); got != 1 {
+		t.Errorf("got nonce %d, want 1", got)
+	}
+	if got := m.IncrementNonce(addr); got != 2 {
+		t.Errorf("got nonce %d, want 2", got)
+	}
+	if got := m.GetAccount(addr).Balance; got.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("balance changed after IncrementNonce: got %s, want 1000", got)
+	}
+}
+
+func TestSetNonce(t *testing.T) {
+	m := NewManager()
+	addr := types.Address{0x01}
+
+	m.SetNonce(addr, 7)
+	if got := m.GetAccount(addr).Nonce; got != 7 {
+		t.Errorf("got nonce %d, want 7", got)
+	}
+
+	m.SetNonce(addr, 3)
+	if got := m.GetAccount(addr).Nonce; got != 3 {
+		t.Errorf("got nonce %d, want 3 (SetNonce overwrites, unlike IncrementNonce)", got)
+	}
+}
+
+// TestAccountDataIntegrity asserts that GetAccount and GetAllAccounts
+// return deep copies: mutating a returned Account's Balance (or the
+// *big.Int passed into SetBalance) must not corrupt the manager's
+// internal state.
+func TestAccountDataIntegrity(t *testing.T) {
+	m := NewManager()
+	addr := types.Address{0x01}
+	m.SetBalance(addr, big.NewInt(1000))
+
+	got := m.GetAccount(addr)
+	got.Balance.SetInt64(999999)
+	if stillThere := m.GetAccount(addr).Balance; stillThere.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("mutating a GetAccount result corrupted manager state: got %s, want 1000", stillThere)
+	}
+
+	fromAll := m.GetAllAccounts()
+	if len(fromAll) != 1 {
+		t.Fatalf("got %d accounts, want 1", len(fromAll))
+	}
+	fromAll[0].Account.Balance.SetInt64(999999)
+	if stillThere := m.GetAccount(addr).Balance; stillThere.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("mutating a GetAllAccounts result corrupted manager state: got %s, want 1000", stillThere)
+	}
+
+	externalBalance := big.NewInt(2000)
+	m.SetBalance(addr, externalBalance)
+	externalBalance.SetInt64(0)
+	if got := m.GetAccount(addr).Balance; got.Cmp(big.NewInt(2000)) != 0 {
+		t.Errorf("mutating the *big.Int passed to SetBalance corrupted manager state: got %s, want 2000", got)
+	}
+}
+
+func BenchmarkGetAllAccounts(b *testing.B) {
+	m := NewManager()
+	for i := 0; i < 1000; i++ {
+		var addr types.Address
+		addr[0] = byte(i)
+		addr[1] = byte(i >> 8)
+		m.SetBalance(addr, big.NewInt(int64(i)))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.GetAllAccounts()
+	}
+}
+
+func TestNewManagerWithOptionsGeneratesFundedAccounts(t *testing.T) {
+	oneEth := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+	fiveEth := new(big.Int).Mul(big.NewInt(5), oneEth)
+
+	m, err := NewManagerWithOptions(ManagerOptions{Count: 3, InitialBalanceWei: fiveEth})
+	if err != nil {
+		t.Fatalf("NewManagerWithOptions: %v", err)
+	}
+
+	all := m.GetAllAccounts()
+	if len(all) != 3 {
+		t.Fatalf("got %d accounts, want 3", len(all))
+	}
+	for _, entry := range all {
+		if entry.Account.Balance.Cmp(fiveEth) != 0 {
+			t.Errorf("account %+v has balance %s, want 5 ETH", entry, entry.Account.Balance)
+		}
+	}
+
+	fifteenEth := new(big.Int).Mul(big.NewInt(15), oneEth)
+	if got := m.GetTotalBalance(); got.Cmp(fifteenEth) != 0 {
+		t.Errorf("got total balance %s, want 15 ETH", got)
+	}
+}
+
+func TestNewManagerWithOptionsIsDeterministic(t *testing.T) {
+	opts := ManagerOptions{Count: 2, InitialBalanceWei: big.NewInt(1), SeedHex: "aa"}
+	m1, err := NewManagerWithOptions(opts)
+	if err != nil {
+		t.Fatalf("NewManagerWithOptions: %v", err)
+	}
+	m2, err := NewManagerWithOptions(opts)
+	if err != nil {
+		t.Fatalf("NewManagerWithOptions: %v", err)
+	}
+
+	addrs1 := make([]types.Address, 0, 2)
+	for _, e := range m1.GetAllAccounts() {
+		addrs1 = append(addrs1, e.Address)
+	}
+	addrs2 := make([]types.Address, 0, 2)
+	for _, e := range m2.GetAllAccounts() {
+		addrs2 = append(addrs2, e.Address)
+	}
+	if addrs1[0] != addrs2[0] || addrs1[1] != addrs2[1] {
+		t.Errorf("got different addresses for the same seed: %x vs %x", addrs1, addrs2)
+	}
+}
+
+func TestNewManagerWithOptionsRejectsInvalidInput(t *testing.T) {
+	if _, err := NewManagerWithOptions(ManagerOptions{Count: 0, InitialBalanceWei: big.NewInt(0)}); err == nil {
+		t.Error("want an error for Count <= 0")
+	}
+	if _, err := NewManagerWithOptions(ManagerOptions{Count: 1, InitialBalanceWei: big.NewInt(-1)}); err == nil {
+		t.Error("want an error for a negative InitialBalanceWei")
+	}
+}
+
+func TestApplyStateDiffAppliesEverythingAtomically(t *testing.T) {
+	m := NewManager()
+	alice := types.Address{0x01}
+	bob := types.Address{0x02}
+	m.SetBalance(alice, big.NewInt(1000))
+
+	err := m.ApplyStateDiff(StateDiff{
+		BalanceDeltas: map[types.Address]*big.Int{
+			alice: big.NewInt(-100),
+			bob:   big.NewInt(100),
+		},
+		NonceDeltas: map[types.Address]uint64{alice: 1},
+	})
+	if err != nil {
+		t.Fatalf("ApplyStateDiff: %v", err)
+	}
+
+	if got := m.GetAccount(alice); got.Balance.Cmp(big.NewInt(900)) != 0 || got.Nonce != 1 {
+		t.Errorf("got alice %+v, want balance 900 and nonce 1", got)
+	}
+	if got := m.GetAccount(bob).Balance; got.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("got bob balance %s, want 100", got)
+	}
+}
+
+func TestApplyStateDiffAbortsLeavingStateUnchanged(t *testing.T) {
+	m := NewManager()
+	alice := types.Address{0x01}
+	bob := types.Address{0x02}
+	m.SetBalance(alice, big.NewInt(50))
+	m.SetBalance(bob, big.NewInt(1000))
+
+	err := m.ApplyStateDiff(StateDiff{
+		BalanceDeltas: map[types.Address]*big.Int{
+			alice: big.NewInt(-100), // would go negative
+			bob:   big.NewInt(-100), // would succeed alone, but the batch must be atomic
+		},
+	})
+	if err == nil {
+		t.Fatal("want an error when a balance delta would go negative")
+	}
+
+	if got := m.GetAccount(alice).Balance; got.Cmp(big.NewInt(50)) != 0 {
+		t.Errorf("got alice balance %s after aborted diff, want unchanged 50", got)
+	}
+	if got := m.GetAccount(bob).Balance; got.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("got bob balance %s after aborted diff, want unchanged 1000", got)
+	}
+}
+
+func TestImportPrivateKeyDerivesAddressAndZeroesBalance(t *testing.T) {
+	m := NewManager()
+	key := "0101010101010101010101010101010101010101010101010101010101010101"
+
+	account, err := m.ImportPrivateKey(key)
+	if err != nil {
+		t.Fatalf("ImportPrivateKey: %v", err)
+	}
+	if account.Balance.Sign() != 0 || account.Nonce != 0 {
+		t.Errorf("got account %+v, want zero balance and nonce", account)
+	}
+	if account.Index != importedIndex {
+		t.Errorf("got Index %d, want importedIndex (%d)", account.Index, importedIndex)
+	}
+
+	addr := deriveAddress(mustDecodeHex(t, key))
+	if got := m.GetAccount(addr); got.Balance.Cmp(account.Balance) != 0 || got.Nonce != account.Nonce || got.Index != account.Index {
+		t.Errorf("GetAccount(derived address) = %+v, want %+v", got, account)
+	}
+}
+
+func TestImportPrivateKeyRejectsBadInput(t *testing.T) {
+	m := NewManager()
+
+	if _, err := m.ImportPrivateKey("not-hex"); err == nil {
+		t.Error("want an error for non-hex input")
+	}
+	if _, err := m.ImportPrivateKey("abcd"); err == nil {
+		t.Error("want an error for a too-short key")
+	}
+}
+
+func TestImportPrivateKeyRejectsDuplicates(t *testing.T) {
+	m := NewManager()
+	key := "0101010101010101010101010101010101010101010101010101010101010101"
+
+	if _, err := m.ImportPrivateKey(key); err != nil {
+		t.Fatalf("first ImportPrivateKey: %v", err)
+	}
+	if _, err := m.ImportPrivateKey(key); err == nil {
+		t.Error("want an error importing the same key twice")
+	}
+}
+
+func TestSnapshotRestoreRoundtrips(t *testing.T) {
+	m := NewManager()
+	addr := types.Address{0xaa}
+	m.SetBalance(addr, big.NewInt(100))
+
+	snap := m.Snapshot()
+
+	m.SetBalance(addr, big.NewInt(999))
+	m.IncrementNonce(addr)
+
+	m.Restore(snap)
+
+	got := m.GetAccount(addr)
+	if got.Balance.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("got balance %s after restore, want 100", got.Balance)
+	}
+	if got.Nonce != 0 {
+		t.Errorf("got nonce %d after restore, want 0", got.Nonce)
+	}
+}
+
+func TestRestoreIsIndependentOfSnapshotSource(t *testing.T) {
+	m := NewManager()
+	addr := types.Address{0xaa}
+	m.SetBalance(addr, big.NewInt(100))
+
+	snap := m.Snapshot()
+	m.Restore(snap)
+	m.SetBalance(addr, big.NewInt(999))
+
+	m.Restore(snap)
+	if got := m.GetAccount(addr).Balance; got.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("got balance %s restoring the same snapshot twice, want 100", got)
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("decoding test hex: %v", err)
+	}
+	return raw
+}
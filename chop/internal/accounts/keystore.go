@@ -0,0 +1,217 @@
+package accounts
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// ErrInvalidPassphrase is returned by ImportKeystore when the supplied
+// passphrase doesn't decrypt the keystore (its MAC doesn't match).
+var ErrInvalidPassphrase = errors.New("accounts: invalid keystore passphrase")
+
+// scrypt parameters match geth's default keystore KDF cost.
+const (
+	scryptN     = 1 << 18
+	scryptR     = 8
+	scryptP     = 1
+	scryptDKLen = 32
+)
+
+// keystoreV3 is the Web3 Secret Storage Definition (V3) JSON layout.
+type keystoreV3 struct {
+	Version int            `json:"version"`
+	ID      string         `json:"id"`
+	Address string         `json:"address"`
+	Crypto  keystoreCrypto `json:"crypto"`
+}
+
+type keystoreCrypto struct {
+	Cipher       string            `json:"cipher"`
+	CipherText   string            `json:"ciphertext"`
+	CipherParams map[string]string `json:"cipherparams"`
+	KDF          string            `json:"kdf"`
+	KDFParams    keystoreKDFParams `json:"kdfparams"`
+	MAC          string            `json:"mac"`
+}
+
+type keystoreKDFParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  string `json:"salt"`
+}
+
+// ExportKeystore encrypts addr's private key with passphrase using
+// scrypt (key derivation) and AES-128-CTR (encryption), per the Web3
+// Secret Storage spec, and returns the resulting keystore JSON.
+func (m *Manager) ExportKeystore(addr types.Address, passphrase string) ([]byte, error) {
+	m.mu.RLock()
+	privateKey, ok := m.privateKeys[addr]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("accounts: no private key held for %x", addr)
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("accounts: generating salt: %w", err)
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, fmt.Errorf("accounts: deriving key: %w", err)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("accounts: generating iv: %w", err)
+	}
+	cipherText, err := aesCTR(derivedKey[:16], iv, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	mac := keystoreMAC(derivedKey[16:32], cipherText)
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("accounts: generating id: %w", err)
+	}
+
+	ks := keystoreV3{
+		Version: 3,
+		ID:      formatUUID(id),
+		Address: hex.EncodeToString(addr[:]),
+		Crypto: keystoreCrypto{
+			Cipher:       "aes-128-ctr",
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: map[string]string{"iv": hex.EncodeToString(iv)},
+			KDF:          "scrypt",
+			KDFParams: keystoreKDFParams{
+				DKLen: scryptDKLen,
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+	}
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// ImportKeystore decrypts a keystore JSON produced by ExportKeystore
+// (or a compatible Web3 V3 keystore) with passphrase, adds the
+// recovered private key to the manager, and returns the new Account.
+// A wrong passphrase is reported as ErrInvalidPassphrase.
+func (m *Manager) ImportKeystore(data []byte, passphrase string) (Account, error) {
+	var ks keystoreV3
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return Account{}, fmt.Errorf("accounts: parsing keystore: %w", err)
+	}
+	if ks.Crypto.Cipher != "aes-128-ctr" || ks.Crypto.KDF != "scrypt" {
+		return Account{}, fmt.Errorf("accounts: unsupported keystore cipher %q / kdf %q", ks.Crypto.Cipher, ks.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return Account{}, fmt.Errorf("accounts: decoding keystore salt: %w", err)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return Account{}, fmt.Errorf("accounts: decoding keystore ciphertext: %w", err)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams["iv"])
+	if err != nil {
+		return Account{}, fmt.Errorf("accounts: decoding keystore iv: %w", err)
+	}
+	wantMAC, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return Account{}, fmt.Errorf("accounts: decoding keystore mac: %w", err)
+	}
+
+	p := ks.Crypto.KDFParams
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, p.N, p.R, p.P, p.DKLen)
+	if err != nil {
+		return Account{}, fmt.Errorf("accounts: deriving key: %w", err)
+	}
+
+	gotMAC := keystoreMAC(derivedKey[16:32], cipherText)
+	if !hmacEqual(gotMAC, wantMAC) {
+		return Account{}, ErrInvalidPassphrase
+	}
+
+	privateKey, err := aesCTR(derivedKey[:16], iv, cipherText)
+	if err != nil {
+		return Account{}, err
+	}
+	return m.importRawKey(privateKey, importedIndex)
+}
+
+// importRawKey is ImportPrivateKey's and NewManagerWithOptions's
+// shared implementation, taking raw key bytes and the Index to record
+// (importedIndex for an externally-supplied key, or the account's
+// position for one generated from a seed).
+func (m *Manager) importRawKey(privateKey []byte, index int) (Account, error) {
+	if len(privateKey) != 32 {
+		return Account{}, fmt.Errorf("accounts: private key must be 32 bytes, got %d", len(privateKey))
+	}
+	addr := deriveAddress(privateKey)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, exists := m.privateKeys[addr]; exists {
+		return Account{}, fmt.Errorf("accounts: %x is already imported", addr)
+	}
+
+	account := Account{Balance: big.NewInt(0), Index: index}
+	m.accounts[addr] = account
+	m.privateKeys[addr] = privateKey
+	return copyAccount(account), nil
+}
+
+func aesCTR(key, iv, input []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("accounts: creating AES cipher: %w", err)
+	}
+	out := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(out, input)
+	return out, nil
+}
+
+// keystoreMAC is the Web3 keystore MAC: Keccak256(macKey || ciphertext).
+func keystoreMAC(macKey, cipherText []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(macKey)
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+func hmacEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	var diff byte
+	for i := range a {
+		diff |= a[i] ^ b[i]
+	}
+	return diff == 0
+}
+
+// formatUUID renders 16 random bytes as a version-4-shaped UUID
+// string. It's cosmetic only: unlike a real keystore's id, nothing in
+// chop relies on its uniqueness.
+func formatUUID(b []byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,50 @@
+package accounts
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExportImportKeystoreRoundtrip(t *testing.T) {
+	source := NewManager()
+	original, err := source.ImportPrivateKey("0101010101010101010101010101010101010101010101010101010101010101")
+	if err != nil {
+		t.Fatalf("ImportPrivateKey: %v", err)
+	}
+	addr := deriveAddress(mustDecodeHex(t, "0101010101010101010101010101010101010101010101010101010101010101"))
+
+	data, err := source.ExportKeystore(addr, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("ExportKeystore: %v", err)
+	}
+
+	dest := NewManager()
+	imported, err := dest.ImportKeystore(data, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("ImportKeystore with the right passphrase: %v", err)
+	}
+	if imported.Balance.Cmp(original.Balance) != 0 || imported.Nonce != original.Nonce || imported.Index != original.Index {
+		t.Errorf("got account %+v, want %+v", imported, original)
+	}
+	if got := dest.GetAccount(addr); got.Balance.Cmp(original.Balance) != 0 || got.Nonce != original.Nonce || got.Index != original.Index {
+		t.Errorf("dest.GetAccount(addr) = %+v, want %+v", got, original)
+	}
+}
+
+func TestImportKeystoreWrongPassphrase(t *testing.T) {
+	source := NewManager()
+	addr := deriveAddress(mustDecodeHex(t, "0202020202020202020202020202020202020202020202020202020202020202"))
+	if _, err := source.ImportPrivateKey("0202020202020202020202020202020202020202020202020202020202020202"); err != nil {
+		t.Fatalf("ImportPrivateKey: %v", err)
+	}
+
+	data, err := source.ExportKeystore(addr, "right passphrase")
+	if err != nil {
+		t.Fatalf("ExportKeystore: %v", err)
+	}
+
+	dest := NewManager()
+	if _, err := dest.ImportKeystore(data, "wrong passphrase"); !errors.Is(err, ErrInvalidPassphrase) {
+		t.Errorf("got err %v, want ErrInvalidPassphrase", err)
+	}
+}
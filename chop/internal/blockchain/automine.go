@@ -0,0 +1,60 @@
+package blockchain
+
+import "time"
+
+// StartAutoMine launches a background goroutine that calls MineBlock
+// once per interval, producing empty blocks when the pending pool is
+// idle. Calling StartAutoMine while auto-mining is already running is
+// a no-op; use StopAutoMine first to change the interval.
+func (c *Chain) StartAutoMine(interval time.Duration) {
+	c.autoMineMu.Lock()
+	defer c.autoMineMu.Unlock()
+	if c.autoMineStop != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	c.autoMineStop = stop
+	c.autoMineDone = done
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				c.MineBlock()
+			}
+		}
+	}()
+}
+
+// StopAutoMine stops a running auto-mine goroutine and waits for it to
+// exit before returning. It is idempotent: calling it when auto-mining
+// isn't running is a no-op.
+func (c *Chain) StopAutoMine() {
+	c.autoMineMu.Lock()
+	stop := c.autoMineStop
+	done := c.autoMineDone
+	c.autoMineStop = nil
+	c.autoMineDone = nil
+	c.autoMineMu.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// AutoMining reports whether the auto-mine goroutine is currently
+// running.
+func (c *Chain) AutoMining() bool {
+	c.autoMineMu.Lock()
+	defer c.autoMineMu.Unlock()
+	return c.autoMineStop != nil
+}
@@ -0,0 +1,46 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartAutoMineIncreasesHeightThenStops(t *testing.T) {
+	c := New()
+
+	c.StartAutoMine(5 * time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	c.StopAutoMine()
+
+	height := len(c.GetAllBlocks())
+	if height == 0 {
+		t.Fatal("got height 0 after auto-mining, want at least one mined block")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if got := len(c.GetAllBlocks()); got != height {
+		t.Errorf("height changed after StopAutoMine: got %d, want %d", got, height)
+	}
+}
+
+func TestStopAutoMineIsIdempotent(t *testing.T) {
+	c := New()
+	c.StopAutoMine() // never started
+
+	c.StartAutoMine(time.Hour)
+	c.StopAutoMine()
+	c.StopAutoMine() // already stopped
+}
+
+func TestStartAutoMineWhileRunningIsNoOp(t *testing.T) {
+	c := New()
+	c.StartAutoMine(time.Hour)
+	c.StartAutoMine(time.Millisecond) // should be ignored
+	if !c.AutoMining() {
+		t.Fatal("want auto-mining to still be running")
+	}
+	c.StopAutoMine()
+	if c.AutoMining() {
+		t.Error("want auto-mining stopped")
+	}
+}
@@ -0,0 +1,183 @@
+package blockchain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// defaultGasLimit is the per-block gas limit new chains start with.
+const defaultGasLimit = 30_000_000
+
+// Block is a mined block: its height, hash, and the transactions
+// included in it. Block 0 (the genesis block) is synthetic - it's
+// never appended to c.blocks, but GenesisBlock/BlockByNumber/
+// BlockByHash all report it so callers (e.g. eth_getBlockByNumber
+// "earliest") can query it like any other block.
+type Block struct {
+	Number       uint64              `json:"number"`
+	Hash         types.Hash          `json:"hash"`
+	ParentHash   types.Hash          `json:"parentHash"`
+	Timestamp    uint64              `json:"timestamp"`
+	GasLimit     uint64              `json:"gasLimit"`
+	GasUsed      uint64              `json:"gasUsed"`
+	Transactions []types.Transaction `json:"transactions"`
+}
+
+// GenesisBlock returns chop's synthetic block 0: no transactions, a
+// zero parent hash, and a deterministic hash so every chop session
+// agrees on it regardless of when it started.
+func (c *Chain) GenesisBlock() Block {
+	return Block{
+		Hash:     genesisHash,
+		GasLimit: c.GetGasLimit(),
+	}
+}
+
+// genesisHash is BlockHash(0, zero parent hash, timestamp 0),
+// computed once since it never changes.
+var genesisHash = BlockHash(0, types.Hash{}, 0)
+
+// BlockByNumber returns the block at height number, or false if no
+// such block has been mined yet. Number 0 always resolves to
+// GenesisBlock.
+func (c *Chain) BlockByNumber(number uint64) (Block, bool) {
+	if number == 0 {
+		return c.GenesisBlock(), true
+	}
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	if number > uint64(len(c.blocks)) {
+		return Block{}, false
+	}
+	return copyBlock(c.blocks[number-1]), true
+}
+
+// BlockByHash returns the block whose hash is hash, or false if no
+// mined block (or the genesis block) matches.
+func (c *Chain) BlockByHash(hash types.Hash) (Block, bool) {
+	if hash == genesisHash {
+		return c.GenesisBlock(), true
+	}
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	for _, b := range c.blocks {
+		if b.Hash == hash {
+			return copyBlock(b), true
+		}
+	}
+	return Block{}, false
+}
+
+// GetGasLimit returns the chain's configured per-block gas limit.
+func (c *Chain) GetGasLimit() uint64 {
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	return c.gasLimit
+}
+
+// SetGasLimit changes the chain's per-block gas limit, taking effect
+// starting with the next mined block.
+func (c *Chain) SetGasLimit(limit uint64) {
+	c.blocksMu.Lock()
+	defer c.blocksMu.Unlock()
+	c.gasLimit = limit
+}
+
+// Head returns the most recently mined block, or the zero Block if
+// none have been mined yet.
+func (c *Chain) Head() Block {
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	if len(c.blocks) == 0 {
+		return Block{}
+	}
+	return c.blocks[len(c.blocks)-1]
+}
+
+// AddBlock mines a new block containing txs, assigning it the next
+// block number, stamping each transaction's BlockNumber, and timestamping
+// it with the current time. Use AddBlockAt to control the timestamp.
+func (c *Chain) AddBlock(txs []types.Transaction) Block {
+	return c.AddBlockAt(txs, uint64(time.Now().Unix()))
+}
+
+// AddBlockAt is AddBlock with an explicit timestamp, used by evm_mine
+// to let test frameworks control block time instead of the wall clock.
+func (c *Chain) AddBlockAt(txs []types.Transaction, timestamp uint64) Block {
+	c.blocksMu.Lock()
+	number := uint64(len(c.blocks)) + 1
+	parentHash := genesisHash
+	if len(c.blocks) > 0 {
+		parentHash = c.blocks[len(c.blocks)-1].Hash
+	}
+	var gasUsed uint64
+	for i := range txs {
+		txs[i].BlockNumber = number
+		gasUsed += txs[i].GasUsed
+	}
+	block := Block{
+		Number:       number,
+		ParentHash:   parentHash,
+		Timestamp:    timestamp,
+		GasLimit:     c.gasLimit,
+		GasUsed:      gasUsed,
+		Transactions: txs,
+	}
+	block.Hash = BlockHash(number, parentHash, timestamp)
+	c.blocks = append(c.blocks, block)
+	hook := c.onNewHead
+	c.blocksMu.Unlock()
+
+	if hook != nil {
+		hook(block)
+	}
+	return block
+}
+
+// OnNewHead registers fn to be called (after the block has been
+// stored) every time AddBlock mines a new block. Only one hook is
+// supported; registering again replaces it.
+func (c *Chain) OnNewHead(fn func(Block)) {
+	c.blocksMu.Lock()
+	defer c.blocksMu.Unlock()
+	c.onNewHead = fn
+}
+
+// BlockHash deterministically derives a block's hash from its number,
+// parent hash, and timestamp, used both for mined blocks and for the
+// synthetic genesis block.
+func BlockHash(number uint64, parentHash types.Hash, timestamp uint64) types.Hash {
+	h := sha256.New()
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], number)
+	h.Write(buf[:])
+	h.Write(parentHash[:])
+	binary.BigEndian.PutUint64(buf[:], timestamp)
+	h.Write(buf[:])
+	var out types.Hash
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+// TransactionHash deterministically derives a hash for tx given the
+// sender's nonce at the time it was sent, used as eth_sendTransaction's
+// return value.
+func TransactionHash(tx types.Transaction, nonce uint64) types.Hash {
+	h := sha256.New()
+	h.Write(tx.From[:])
+	if tx.To != nil {
+		h.Write(tx.To[:])
+	}
+	h.Write(tx.Data)
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], tx.Value)
+	h.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], nonce)
+	h.Write(buf[:])
+	var out types.Hash
+	copy(out[:], h.Sum(nil))
+	return out
+}
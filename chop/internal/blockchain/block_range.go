@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// copyBlock returns b with its Transactions slice copied, so a caller
+// can't mutate the chain's stored block by appending to (or
+// reordering) the slice it's given.
+func copyBlock(b Block) Block {
+	txs := make([]types.Transaction, len(b.Transactions))
+	copy(txs, b.Transactions)
+	return Block{
+		Number:       b.Number,
+		Hash:         b.Hash,
+		ParentHash:   b.ParentHash,
+		Timestamp:    b.Timestamp,
+		GasLimit:     b.GasLimit,
+		GasUsed:      b.GasUsed,
+		Transactions: txs,
+	}
+}
+
+// GetAllBlocks returns every mined block, in order, as copies.
+func (c *Chain) GetAllBlocks() []Block {
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	blocks := make([]Block, len(c.blocks))
+	for i, b := range c.blocks {
+		blocks[i] = copyBlock(b)
+	}
+	return blocks
+}
+
+// GetBlockRange returns copies of the mined blocks numbered in
+// [start, end) — start inclusive, end exclusive. It errors if
+// start > end, or if start exceeds the chain's current height. end
+// beyond the chain height is clamped, matching GetAllBlocks for a
+// range that runs past the tip.
+func (c *Chain) GetBlockRange(start, end uint64) ([]Block, error) {
+	if start > end {
+		return nil, fmt.Errorf("blockchain: range start %d is after end %d", start, end)
+	}
+
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	height := uint64(len(c.blocks))
+	if start > height {
+		return nil, fmt.Errorf("blockchain: range start %d exceeds chain height %d", start, height)
+	}
+	if end > height+1 {
+		end = height + 1
+	}
+
+	blocks := make([]Block, 0, end-start)
+	for n := start; n < end; n++ {
+		if n == 0 {
+			continue // there is no block 0
+		}
+		blocks = append(blocks, copyBlock(c.blocks[n-1]))
+	}
+	return blocks, nil
+}
@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestGetAllBlocksReturnsCopies(t *testing.T) {
+	c := New()
+	c.AddBlock([]types.Transaction{{Hash: types.Hash{0x01}}})
+	c.AddBlock([]types.Transaction{{Hash: types.Hash{0x02}}})
+
+	blocks := c.GetAllBlocks()
+	if len(blocks) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(blocks))
+	}
+
+	blocks[0].Transactions = append(blocks[0].Transactions, types.Transaction{Hash: types.Hash{0xff}})
+	if got := c.GetAllBlocks()[0].Transactions; len(got) != 1 {
+		t.Errorf("mutating a GetAllBlocks result corrupted chain state: got %d transactions in block 1, want 1", len(got))
+	}
+}
+
+func TestGetBlockRangeNormal(t *testing.T) {
+	c := New()
+	for i := 0; i < 5; i++ {
+		c.AddBlock(nil)
+	}
+
+	blocks, err := c.GetBlockRange(2, 4)
+	if err != nil {
+		t.Fatalf("GetBlockRange: %v", err)
+	}
+	if len(blocks) != 2 || blocks[0].Number != 2 || blocks[1].Number != 3 {
+		t.Errorf("got blocks %+v, want numbers [2 3]", blocks)
+	}
+}
+
+func TestGetBlockRangeEmptyRange(t *testing.T) {
+	c := New()
+	c.AddBlock(nil)
+
+	blocks, err := c.GetBlockRange(1, 1)
+	if err != nil {
+		t.Fatalf("GetBlockRange: %v", err)
+	}
+	if len(blocks) != 0 {
+		t.Errorf("got %d blocks, want 0 for an empty range", len(blocks))
+	}
+}
+
+func TestGetBlockRangeOutOfBounds(t *testing.T) {
+	c := New()
+	c.AddBlock(nil)
+
+	if _, err := c.GetBlockRange(5, 10); err == nil {
+		t.Error("want an error when start exceeds chain height")
+	}
+	if _, err := c.GetBlockRange(3, 1); err == nil {
+		t.Error("want an error when start > end")
+	}
+
+	// end past the tip is clamped, not an error.
+	blocks, err := c.GetBlockRange(1, 100)
+	if err != nil {
+		t.Fatalf("GetBlockRange with end past the tip: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Errorf("got %d blocks, want 1", len(blocks))
+	}
+}
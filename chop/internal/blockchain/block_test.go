@@ -0,0 +1,63 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestGenesisBlockIsStableAcrossSessions(t *testing.T) {
+	c1, c2 := New(), New()
+	if c1.GenesisBlock().Hash != c2.GenesisBlock().Hash {
+		t.Error("want the genesis hash to be the same for every chain")
+	}
+	if got := c1.GenesisBlock().Number; got != 0 {
+		t.Errorf("got genesis block number %d, want 0", got)
+	}
+}
+
+func TestBlockByNumberFindsGenesisAndMinedBlocks(t *testing.T) {
+	c := New()
+	mined := c.AddBlock([]types.Transaction{{Hash: types.Hash{0x01}}})
+
+	genesis, ok := c.BlockByNumber(0)
+	if !ok || genesis.Hash != c.GenesisBlock().Hash {
+		t.Error("want BlockByNumber(0) to return the genesis block")
+	}
+
+	got, ok := c.BlockByNumber(1)
+	if !ok || got.Hash != mined.Hash {
+		t.Error("want BlockByNumber(1) to return the mined block")
+	}
+
+	if _, ok := c.BlockByNumber(2); ok {
+		t.Error("want BlockByNumber to report not found past the chain height")
+	}
+}
+
+func TestBlockByHashFindsGenesisAndMinedBlocks(t *testing.T) {
+	c := New()
+	mined := c.AddBlock(nil)
+
+	if got, ok := c.BlockByHash(c.GenesisBlock().Hash); !ok || got.Number != 0 {
+		t.Error("want BlockByHash to find the genesis block by its hash")
+	}
+	if got, ok := c.BlockByHash(mined.Hash); !ok || got.Number != mined.Number {
+		t.Error("want BlockByHash to find the mined block by its hash")
+	}
+	if _, ok := c.BlockByHash(types.Hash{0xff}); ok {
+		t.Error("want BlockByHash to report not found for an unknown hash")
+	}
+}
+
+func TestAddBlockChainsParentHashes(t *testing.T) {
+	c := New()
+	first := c.AddBlock(nil)
+	if first.ParentHash != c.GenesisBlock().Hash {
+		t.Error("want the first mined block's parent hash to be the genesis hash")
+	}
+	second := c.AddBlock(nil)
+	if second.ParentHash != first.Hash {
+		t.Error("want each mined block's parent hash to be the previous block's hash")
+	}
+}
@@ -0,0 +1,135 @@
+// Package blockchain holds the in-memory chain state for a chop
+// session.
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/contracts"
+	"github.com/evmts/chop/internal/history"
+	"github.com/evmts/chop/internal/types"
+)
+
+// Chain is the in-memory chain state for a chop session: executed call
+// history plus analytics derived from it. The EVM execution layer and
+// RPC server both operate against a single shared Chain.
+type Chain struct {
+	History   history.Recorder
+	Contracts *contracts.Tracker
+	Accounts  *accounts.Manager
+
+	codeMu sync.RWMutex
+	code   map[types.Address][]byte
+
+	storageMu sync.RWMutex
+	storage   map[types.Address]map[types.Hash]types.Hash
+
+	blocksMu  sync.RWMutex
+	blocks    []Block
+	gasLimit  uint64
+	onNewHead func(Block)
+
+	pendingMu sync.Mutex
+	pending   []types.Transaction
+
+	autoMineMu   sync.Mutex
+	autoMineStop chan struct{}
+	autoMineDone chan struct{}
+
+	snapshotsMu    sync.Mutex
+	snapshots      []chainSnapshot
+	nextSnapshotID uint64
+}
+
+// New returns an empty Chain ready to record calls.
+func New() *Chain {
+	return &Chain{
+		History:   history.NewStore(),
+		Contracts: contracts.NewTracker(),
+		Accounts:  accounts.NewManager(),
+		code:      make(map[types.Address][]byte),
+		storage:   make(map[types.Address]map[types.Hash]types.Hash),
+		gasLimit:  defaultGasLimit,
+	}
+}
+
+// SetCode records addr as having deployed code. Called after a
+// successful contract creation.
+func (c *Chain) SetCode(addr types.Address, code []byte) {
+	c.codeMu.Lock()
+	defer c.codeMu.Unlock()
+	c.code[addr] = code
+}
+
+// CodeAt returns the code deployed at addr, or nil if addr has none
+// (matching real chains, where calling a codeless address is valid
+// and simply has no effect).
+func (c *Chain) CodeAt(addr types.Address) []byte {
+	c.codeMu.RLock()
+	defer c.codeMu.RUnlock()
+	return c.code[addr]
+}
+
+// SetStorage records value as the contents of addr's storage slot.
+// Called after a call whose PostState reports the slot changed.
+func (c *Chain) SetStorage(addr types.Address, slot, value types.Hash) {
+	c.storageMu.Lock()
+	defer c.storageMu.Unlock()
+	slots, ok := c.storage[addr]
+	if !ok {
+		slots = make(map[types.Hash]types.Hash)
+		c.storage[addr] = slots
+	}
+	slots[slot] = value
+}
+
+// StorageAt returns the contents of addr's storage slot, or the zero
+// hash if it's never been written (matching real chains, where every
+// slot reads as zero until set).
+func (c *Chain) StorageAt(addr types.Address, slot types.Hash) types.Hash {
+	c.storageMu.RLock()
+	defer c.storageMu.RUnlock()
+	return c.storage[addr][slot]
+}
+
+// CodeAddresses returns every address that has contract code set via
+// SetCode, in no particular order. Used by callers that need to
+// enumerate all known contracts, e.g. dumping state to a genesis
+// file.
+func (c *Chain) CodeAddresses() []types.Address {
+	c.codeMu.RLock()
+	defer c.codeMu.RUnlock()
+	addrs := make([]types.Address, 0, len(c.code))
+	for addr := range c.code {
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// AllStorage returns a copy of every storage slot SetStorage has
+// recorded for addr.
+func (c *Chain) AllStorage(addr types.Address) map[types.Hash]types.Hash {
+	c.storageMu.RLock()
+	defer c.storageMu.RUnlock()
+	slots := c.storage[addr]
+	out := make(map[types.Hash]types.Hash, len(slots))
+	for k, v := range slots {
+		out[k] = v
+	}
+	return out
+}
+
+// RecordCall appends an executed call to history and folds it into the
+// per-contract analytics tally.
+func (c *Chain) RecordCall(call types.CallResult) history.Entry {
+	entry := c.History.Add(call)
+	c.Contracts.Record(call)
+	return entry
+}
+
+// Reset clears all session state: history and analytics tallies.
+func (c *Chain) Reset() {
+	c.History.Reset()
+	c.Contracts.Reset()
+}
@@ -0,0 +1,58 @@
+package blockchain
+
+import "github.com/evmts/chop/internal/types"
+
+// AddPendingTransaction queues tx to be included in the next call to
+// MineBlock, instead of mining it immediately.
+func (c *Chain) AddPendingTransaction(tx types.Transaction) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	c.pending = append(c.pending, tx)
+}
+
+// GetPendingTransactions returns a copy of the queued, not-yet-mined
+// transactions, in the order they'll be mined.
+func (c *Chain) GetPendingTransactions() []types.Transaction {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	pending := make([]types.Transaction, len(c.pending))
+	copy(pending, c.pending)
+	return pending
+}
+
+// MineBlock drains the pending pool into a new block, taking
+// transactions from the front of the queue until the next one would
+// exceed the chain's gas limit. Transactions that don't fit stay
+// queued for the next MineBlock call.
+func (c *Chain) MineBlock() Block {
+	return c.AddBlock(c.drainPending())
+}
+
+// MineBlockAt is MineBlock with an explicit timestamp, used by
+// evm_mine to let test frameworks control block time instead of the
+// wall clock.
+func (c *Chain) MineBlockAt(timestamp uint64) Block {
+	return c.AddBlockAt(c.drainPending(), timestamp)
+}
+
+// drainPending takes transactions from the front of the pending queue
+// until the next one would exceed the chain's gas limit, leaving
+// whatever doesn't fit queued for the next mine.
+func (c *Chain) drainPending() []types.Transaction {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	limit := c.GetGasLimit()
+	var selected []types.Transaction
+	var used uint64
+	i := 0
+	for ; i < len(c.pending); i++ {
+		tx := c.pending[i]
+		if used+tx.GasLimit > limit {
+			break
+		}
+		used += tx.GasLimit
+		selected = append(selected, tx)
+	}
+	c.pending = c.pending[i:]
+	return selected
+}
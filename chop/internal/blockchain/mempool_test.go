@@ -0,0 +1,47 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestMineBlockDrainsPendingUpToGasLimit(t *testing.T) {
+	c := New()
+	c.gasLimit = 50_000
+
+	tx1 := types.Transaction{Hash: types.Hash{0x01}, GasLimit: 21_000}
+	tx2 := types.Transaction{Hash: types.Hash{0x02}, GasLimit: 21_000}
+	tx3 := types.Transaction{Hash: types.Hash{0x03}, GasLimit: 21_000} // pushes cumulative past 50,000
+	c.AddPendingTransaction(tx1)
+	c.AddPendingTransaction(tx2)
+	c.AddPendingTransaction(tx3)
+
+	if got := len(c.GetPendingTransactions()); got != 3 {
+		t.Fatalf("got %d pending before mining, want 3", got)
+	}
+
+	block := c.MineBlock()
+	if len(block.Transactions) != 2 {
+		t.Fatalf("got %d transactions in mined block, want 2", len(block.Transactions))
+	}
+	if block.Transactions[0].Hash != tx1.Hash || block.Transactions[1].Hash != tx2.Hash {
+		t.Errorf("got block transactions %+v, want [tx1 tx2]", block.Transactions)
+	}
+
+	remaining := c.GetPendingTransactions()
+	if len(remaining) != 1 || remaining[0].Hash != tx3.Hash {
+		t.Errorf("got %d remaining pending transactions, want tx3 left over", len(remaining))
+	}
+}
+
+func TestMineBlockWithEmptyPoolMinesEmptyBlock(t *testing.T) {
+	c := New()
+	block := c.MineBlock()
+	if len(block.Transactions) != 0 {
+		t.Errorf("got %d transactions, want 0", len(block.Transactions))
+	}
+	if block.Number != 1 {
+		t.Errorf("got block number %d, want 1", block.Number)
+	}
+}
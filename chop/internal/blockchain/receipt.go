@@ -0,0 +1,90 @@
+package blockchain
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// GetReceipt builds the Receipt for the transaction identified by
+// txID (a hex string, with or without "0x", matching types.Hash's
+// encoding), computing cumulative gas used and global log indices
+// across every transaction mined before it in the same block. It
+// returns an error if txID doesn't match a mined transaction.
+func (c *Chain) GetReceipt(txID string) (*types.Receipt, error) {
+	hash, err := parseTxHash(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+
+	for _, block := range c.blocks {
+		var cumulativeGasUsed uint64
+		var logIndex uint64
+		for _, tx := range block.Transactions {
+			cumulativeGasUsed += tx.GasUsed
+			if tx.Hash != hash {
+				logIndex += uint64(len(tx.Logs))
+				continue
+			}
+
+			logs := make([]types.ReceiptLog, 0, len(tx.Logs))
+			for _, log := range tx.Logs {
+				logs = append(logs, types.ReceiptLog{Log: log, Index: logIndex})
+				logIndex++
+			}
+			return &types.Receipt{
+				TransactionHash:   tx.Hash,
+				BlockNumber:       tx.BlockNumber,
+				From:              tx.From,
+				To:                tx.To,
+				Status:            tx.Status,
+				GasUsed:           tx.GasUsed,
+				CumulativeGasUsed: cumulativeGasUsed,
+				Logs:              logs,
+				ContractAddress:   tx.DeployedAddress,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("blockchain: no transaction found for %s", txID)
+}
+
+// TransactionByHash returns the mined transaction identified by txID
+// (see GetReceipt for its accepted hex formats), or false if no mined
+// transaction matches.
+func (c *Chain) TransactionByHash(txID string) (types.Transaction, bool) {
+	hash, err := parseTxHash(txID)
+	if err != nil {
+		return types.Transaction{}, false
+	}
+
+	c.blocksMu.RLock()
+	defer c.blocksMu.RUnlock()
+	for _, block := range c.blocks {
+		for _, tx := range block.Transactions {
+			if tx.Hash == hash {
+				return tx, true
+			}
+		}
+	}
+	return types.Transaction{}, false
+}
+
+// parseTxHash decodes a hex transaction hash, with or without a "0x"
+// prefix, into a types.Hash.
+func parseTxHash(txID string) (types.Hash, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(txID, "0x"))
+	if err != nil {
+		return types.Hash{}, fmt.Errorf("blockchain: invalid transaction hash %q: %w", txID, err)
+	}
+	var hash types.Hash
+	if len(raw) != len(hash) {
+		return types.Hash{}, fmt.Errorf("blockchain: transaction hash %q must be %d bytes, got %d", txID, len(hash), len(raw))
+	}
+	copy(hash[:], raw)
+	return hash, nil
+}
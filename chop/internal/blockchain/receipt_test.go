@@ -0,0 +1,53 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestGetReceiptComputesCumulativeGasAndLogIndices(t *testing.T) {
+	c := New()
+	tx1 := types.Transaction{
+		Hash:    types.Hash{0x01},
+		GasUsed: 21000,
+		Status:  true,
+		Logs:    []types.Log{{Address: types.Address{0xaa}}, {Address: types.Address{0xbb}}},
+	}
+	tx2 := types.Transaction{
+		Hash:    types.Hash{0x02},
+		GasUsed: 50000,
+		Status:  true,
+		Logs:    []types.Log{{Address: types.Address{0xcc}}},
+	}
+	c.AddBlock([]types.Transaction{tx1, tx2})
+
+	receipt1, err := c.GetReceipt("0100000000000000000000000000000000000000000000000000000000000000")
+	if err != nil {
+		t.Fatalf("GetReceipt(tx1): %v", err)
+	}
+	if receipt1.CumulativeGasUsed != 21000 {
+		t.Errorf("got cumulative gas %d, want 21000", receipt1.CumulativeGasUsed)
+	}
+	if len(receipt1.Logs) != 2 || receipt1.Logs[0].Index != 0 || receipt1.Logs[1].Index != 1 {
+		t.Errorf("got logs %+v, want indices 0 and 1", receipt1.Logs)
+	}
+
+	receipt2, err := c.GetReceipt("0x0200000000000000000000000000000000000000000000000000000000000000")
+	if err != nil {
+		t.Fatalf("GetReceipt(tx2): %v", err)
+	}
+	if receipt2.CumulativeGasUsed != 71000 {
+		t.Errorf("got cumulative gas %d, want 71000 (21000+50000)", receipt2.CumulativeGasUsed)
+	}
+	if len(receipt2.Logs) != 1 || receipt2.Logs[0].Index != 2 {
+		t.Errorf("got logs %+v, want a single log continuing at index 2", receipt2.Logs)
+	}
+}
+
+func TestGetReceiptUnknownTxReturnsError(t *testing.T) {
+	c := New()
+	if _, err := c.GetReceipt("0x00"); err == nil {
+		t.Error("want an error for an unknown transaction hash")
+	}
+}
@@ -0,0 +1,116 @@
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/types"
+)
+
+// chainSnapshot is a point-in-time copy of everything Snapshot/Revert
+// need to restore: mined blocks, the pending pool, deployed code, and
+// account state.
+type chainSnapshot struct {
+	id       string
+	blocks   []Block
+	pending  []types.Transaction
+	code     map[types.Address][]byte
+	storage  map[types.Address]map[types.Hash]types.Hash
+	accounts accounts.Snapshot
+}
+
+// Snapshot captures the chain's current state — blocks, pending
+// transactions, deployed code, contract storage, and account
+// balances/nonces — and returns an id that can later be passed to
+// Revert. It's modeled on Hardhat's evm_snapshot/evm_revert.
+func (c *Chain) Snapshot() string {
+	c.blocksMu.RLock()
+	blocks := make([]Block, len(c.blocks))
+	for i, b := range c.blocks {
+		blocks[i] = copyBlock(b)
+	}
+	c.blocksMu.RUnlock()
+
+	c.pendingMu.Lock()
+	pending := make([]types.Transaction, len(c.pending))
+	copy(pending, c.pending)
+	c.pendingMu.Unlock()
+
+	c.codeMu.RLock()
+	code := make(map[types.Address][]byte, len(c.code))
+	for addr, b := range c.code {
+		cp := make([]byte, len(b))
+		copy(cp, b)
+		code[addr] = cp
+	}
+	c.codeMu.RUnlock()
+
+	c.storageMu.RLock()
+	storage := make(map[types.Address]map[types.Hash]types.Hash, len(c.storage))
+	for addr, slots := range c.storage {
+		cp := make(map[types.Hash]types.Hash, len(slots))
+		for slot, value := range slots {
+			cp[slot] = value
+		}
+		storage[addr] = cp
+	}
+	c.storageMu.RUnlock()
+
+	snap := chainSnapshot{
+		blocks:   blocks,
+		pending:  pending,
+		code:     code,
+		storage:  storage,
+		accounts: c.Accounts.Snapshot(),
+	}
+
+	c.snapshotsMu.Lock()
+	defer c.snapshotsMu.Unlock()
+	c.nextSnapshotID++
+	snap.id = fmt.Sprintf("0x%x", c.nextSnapshotID)
+	c.snapshots = append(c.snapshots, snap)
+	return snap.id
+}
+
+// Revert restores the chain (blocks, pending transactions, code,
+// storage, and its Accounts manager) to the state captured by the
+// snapshot with the given id, and discards that
+// snapshot along with every snapshot taken after it — matching
+// evm_revert, which a snapshot can only be used once. It errors if id
+// doesn't name a live snapshot.
+func (c *Chain) Revert(id string) error {
+	c.snapshotsMu.Lock()
+	index := -1
+	for i, s := range c.snapshots {
+		if s.id == id {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		c.snapshotsMu.Unlock()
+		return fmt.Errorf("blockchain: no snapshot %q", id)
+	}
+	snap := c.snapshots[index]
+	c.snapshots = c.snapshots[:index]
+	c.snapshotsMu.Unlock()
+
+	c.blocksMu.Lock()
+	c.blocks = snap.blocks
+	c.blocksMu.Unlock()
+
+	c.pendingMu.Lock()
+	c.pending = snap.pending
+	c.pendingMu.Unlock()
+
+	c.codeMu.Lock()
+	c.code = snap.code
+	c.codeMu.Unlock()
+
+	c.storageMu.Lock()
+	c.storage = snap.storage
+	c.storageMu.Unlock()
+
+	c.Accounts.Restore(snap.accounts)
+	return nil
+}
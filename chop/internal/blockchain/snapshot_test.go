@@ -0,0 +1,79 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestSnapshotRevertRestoresHeightAndTxCount(t *testing.T) {
+	c := New()
+	c.AddBlock([]types.Transaction{{Hash: types.Hash{0x01}}})
+
+	addr := types.Address{0xaa}
+	c.Accounts.SetBalance(addr, big.NewInt(100))
+
+	id := c.Snapshot()
+
+	c.AddBlock([]types.Transaction{{Hash: types.Hash{0x02}}})
+	c.AddBlock([]types.Transaction{{Hash: types.Hash{0x03}}})
+	c.Accounts.SetBalance(addr, big.NewInt(999))
+
+	if err := c.Revert(id); err != nil {
+		t.Fatalf("Revert: %v", err)
+	}
+
+	blocks := c.GetAllBlocks()
+	if len(blocks) != 1 {
+		t.Fatalf("got height %d after revert, want 1", len(blocks))
+	}
+	if len(blocks[0].Transactions) != 1 {
+		t.Errorf("got %d transactions in block 1, want 1", len(blocks[0].Transactions))
+	}
+	if got := c.Accounts.GetAccount(addr).Balance; got.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("got balance %s after revert, want 100", got)
+	}
+}
+
+func TestSnapshotRevertRestoresStorage(t *testing.T) {
+	c := New()
+	addr := types.Address{0xaa}
+	slot := types.Hash{0x01}
+	c.SetStorage(addr, slot, types.Hash{0x11})
+
+	id := c.Snapshot()
+
+	c.SetStorage(addr, slot, types.Hash{0x22})
+
+	if err := c.Revert(id); err != nil {
+		t.Fatalf("Revert: %v", err)
+	}
+
+	if got := c.StorageAt(addr, slot); got != (types.Hash{0x11}) {
+		t.Errorf("got storage %x after revert, want %x", got, types.Hash{0x11})
+	}
+}
+
+func TestRevertDiscardsLaterSnapshots(t *testing.T) {
+	c := New()
+	first := c.Snapshot()
+	c.AddBlock(nil)
+	second := c.Snapshot()
+	c.AddBlock(nil)
+
+	if err := c.Revert(first); err != nil {
+		t.Fatalf("Revert: %v", err)
+	}
+
+	if err := c.Revert(second); err == nil {
+		t.Error("want an error reverting to a snapshot taken after the one already reverted to")
+	}
+}
+
+func TestRevertUnknownIDReturnsError(t *testing.T) {
+	c := New()
+	if err := c.Revert("0xdeadbeef"); err == nil {
+		t.Error("want an error reverting to a snapshot id that was never taken")
+	}
+}
@@ -0,0 +1,49 @@
+package bytecode
+
+// Category groups related opcodes for gas-profiling summaries: which
+// kind of work a call's gas went towards. GasCategories lists every
+// category in the fixed order profiles and bar charts should render
+// them; "other" always sorts last.
+const (
+	CategoryArithmetic = "arithmetic"
+	CategoryStorage    = "storage"
+	CategoryMemory     = "memory"
+	CategoryCalls      = "calls"
+	CategoryOther      = "other"
+)
+
+// GasCategories lists every category in the fixed order a gas profile
+// should render them.
+var GasCategories = []string{CategoryArithmetic, CategoryStorage, CategoryMemory, CategoryCalls, CategoryOther}
+
+// categoryByName maps each grouped opcode's mnemonic to its category;
+// anything absent is CategoryOther.
+var categoryByName = map[string]string{
+	"ADD": CategoryArithmetic, "MUL": CategoryArithmetic, "SUB": CategoryArithmetic,
+	"DIV": CategoryArithmetic, "SDIV": CategoryArithmetic, "MOD": CategoryArithmetic,
+	"SMOD": CategoryArithmetic, "ADDMOD": CategoryArithmetic, "MULMOD": CategoryArithmetic,
+	"EXP": CategoryArithmetic, "SIGNEXTEND": CategoryArithmetic,
+	"LT": CategoryArithmetic, "GT": CategoryArithmetic, "SLT": CategoryArithmetic,
+	"SGT": CategoryArithmetic, "EQ": CategoryArithmetic, "ISZERO": CategoryArithmetic,
+	"AND": CategoryArithmetic, "OR": CategoryArithmetic, "XOR": CategoryArithmetic,
+	"NOT": CategoryArithmetic, "BYTE": CategoryArithmetic, "SHL": CategoryArithmetic,
+	"SHR": CategoryArithmetic, "SAR": CategoryArithmetic, "SHA3": CategoryArithmetic,
+
+	"SLOAD": CategoryStorage, "SSTORE": CategoryStorage,
+	"TLOAD": CategoryStorage, "TSTORE": CategoryStorage,
+
+	"MLOAD": CategoryMemory, "MSTORE": CategoryMemory, "MSTORE8": CategoryMemory,
+	"MSIZE": CategoryMemory, "MCOPY": CategoryMemory,
+
+	"CALL": CategoryCalls, "CALLCODE": CategoryCalls, "DELEGATECALL": CategoryCalls,
+	"STATICCALL": CategoryCalls, "CREATE": CategoryCalls, "CREATE2": CategoryCalls,
+}
+
+// Category returns which gas-profiling category op belongs to,
+// CategoryOther if it isn't grouped with the others.
+func (o Opcode) Category() string {
+	if cat, ok := categoryByName[o.Name]; ok {
+		return cat
+	}
+	return CategoryOther
+}
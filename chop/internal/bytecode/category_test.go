@@ -0,0 +1,25 @@
+package bytecode
+
+import "testing"
+
+func TestCategoryGroupsKnownOpcodes(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"ADD", CategoryArithmetic},
+		{"SSTORE", CategoryStorage},
+		{"MLOAD", CategoryMemory},
+		{"CALL", CategoryCalls},
+		{"JUMPDEST", CategoryOther},
+	}
+	for _, tt := range tests {
+		op, ok := ByName(tt.name)
+		if !ok {
+			t.Fatalf("ByName(%q): not found", tt.name)
+		}
+		if got := op.Category(); got != tt.want {
+			t.Errorf("%s.Category() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package bytecode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CFGEdgeKind classifies a CFGEdge, so a renderer can style fallthrough,
+// taken-jump, and unresolved edges differently.
+type CFGEdgeKind string
+
+const (
+	// EdgeFallthrough connects a block to the block immediately
+	// following it in PC order, taken when the block doesn't end in a
+	// jump (or, for JUMPI, when the condition is false).
+	EdgeFallthrough CFGEdgeKind = "fallthrough"
+	// EdgeJump connects a block ending in JUMP or JUMPI to the
+	// resolved target of a static (PUSHn-then-jump) jump.
+	EdgeJump CFGEdgeKind = "jump"
+)
+
+// CFGNode is one basic block in an exported control-flow graph.
+type CFGNode struct {
+	ID      int
+	StartPC int
+	EndPC   int
+	// Mnemonics lists the opcode name of every instruction in the
+	// block, in order, for labeling.
+	Mnemonics []string
+}
+
+// CFGEdge is a directed edge between two blocks, identified by CFGNode
+// ID. Unresolved is set when the edge represents a jump whose target
+// couldn't be determined statically (e.g. computed from calldata or
+// storage rather than an immediately preceding PUSH) — To is -1 in
+// that case.
+type CFGEdge struct {
+	From       int
+	To         int
+	Kind       CFGEdgeKind
+	Unresolved bool
+}
+
+// CFG is a contract's control-flow graph: one node per basic block,
+// plus the fallthrough/jump edges between them.
+type CFG struct {
+	Nodes []CFGNode
+	Edges []CFGEdge
+}
+
+// blockIndexByStartPC maps a basic block's StartPC to its index, for
+// resolving jump targets to node IDs.
+func blockIndexByStartPC(blocks []BasicBlock) map[int]int {
+	m := make(map[int]int, len(blocks))
+	for i, b := range blocks {
+		m[b.StartPC] = i
+	}
+	return m
+}
+
+// staticJumpTarget returns the jump target of block's terminating
+// JUMP/JUMPI, if it was pushed immediately beforehand (the standard
+// "PUSHn <target> JUMP[I]" pattern), and whether one was found. A
+// target pushed further back, or computed at runtime, isn't
+// resolvable this way and is reported as unresolved.
+func staticJumpTarget(block BasicBlock) (int, bool) {
+	n := len(block.Instructions)
+	if n < 2 {
+		return 0, false
+	}
+	push := block.Instructions[n-2]
+	if push.Push == nil {
+		return 0, false
+	}
+	target := 0
+	for _, b := range push.Push {
+		target = target<<8 | int(b)
+	}
+	return target, true
+}
+
+// ExportCFG builds result's control-flow graph: one CFGNode per basic
+// block, with fallthrough edges to the next block in PC order and
+// jump edges resolved from the standard "PUSHn <target> JUMP[I]"
+// pattern. A JUMP/JUMPI whose target can't be resolved that way
+// produces an Unresolved edge with To -1, marking a dynamic jump.
+func ExportCFG(result *DisassemblyResult) (CFG, error) {
+	if result == nil {
+		return CFG{}, fmt.Errorf("bytecode: cannot export CFG of a nil disassembly")
+	}
+
+	byStart := blockIndexByStartPC(result.BasicBlocks)
+	cfg := CFG{Nodes: make([]CFGNode, len(result.BasicBlocks))}
+
+	for i, block := range result.BasicBlocks {
+		mnemonics := make([]string, len(block.Instructions))
+		for j, inst := range block.Instructions {
+			mnemonics[j] = inst.Opcode.Name
+		}
+		cfg.Nodes[i] = CFGNode{ID: i, StartPC: block.StartPC, EndPC: block.EndPC, Mnemonics: mnemonics}
+
+		if len(block.Instructions) == 0 {
+			continue
+		}
+		last := block.Instructions[len(block.Instructions)-1]
+
+		switch last.Opcode.Byte {
+		case 0x56: // JUMP
+			cfg.Edges = append(cfg.Edges, jumpEdge(i, block, byStart))
+		case 0x57: // JUMPI
+			cfg.Edges = append(cfg.Edges, jumpEdge(i, block, byStart))
+			if next, ok := nextBlock(result.BasicBlocks, i); ok {
+				cfg.Edges = append(cfg.Edges, CFGEdge{From: i, To: next, Kind: EdgeFallthrough})
+			}
+		case 0x00, 0xf3, 0xfd, 0xff: // STOP, RETURN, REVERT, SELFDESTRUCT: no successors
+		default:
+			if next, ok := nextBlock(result.BasicBlocks, i); ok {
+				cfg.Edges = append(cfg.Edges, CFGEdge{From: i, To: next, Kind: EdgeFallthrough})
+			}
+		}
+	}
+	return cfg, nil
+}
+
+func jumpEdge(from int, block BasicBlock, byStart map[int]int) CFGEdge {
+	target, ok := staticJumpTarget(block)
+	if !ok {
+		return CFGEdge{From: from, To: -1, Kind: EdgeJump, Unresolved: true}
+	}
+	to, ok := byStart[target]
+	if !ok {
+		return CFGEdge{From: from, To: -1, Kind: EdgeJump, Unresolved: true}
+	}
+	return CFGEdge{From: from, To: to, Kind: EdgeJump}
+}
+
+func nextBlock(blocks []BasicBlock, i int) (int, bool) {
+	if i+1 < len(blocks) {
+		return i + 1, true
+	}
+	return 0, false
+}
+
+// ToDOT renders cfg as a Graphviz "dot" digraph: one node per basic
+// block labeled with its PC range and instructions, jump edges solid,
+// fallthrough edges dashed, and unresolved (dynamic) jumps drawn in
+// red so they stand out from statically resolved control flow.
+func (cfg CFG) ToDOT() string {
+	var b strings.Builder
+	b.WriteString("digraph CFG {\n")
+	b.WriteString("  node [shape=box, fontname=monospace];\n")
+
+	for _, node := range cfg.Nodes {
+		label := fmt.Sprintf("block %d\\nPC %d-%d\\n%s", node.ID, node.StartPC, node.EndPC, strings.Join(node.Mnemonics, "\\n"))
+		fmt.Fprintf(&b, "  n%d [label=\"%s\"];\n", node.ID, label)
+	}
+
+	for _, edge := range cfg.Edges {
+		if edge.Unresolved {
+			fmt.Fprintf(&b, "  n%d -> unresolved%d [label=\"dynamic jump\", color=red, style=dashed];\n", edge.From, edge.From)
+			fmt.Fprintf(&b, "  unresolved%d [shape=ellipse, label=\"?\", color=red];\n", edge.From)
+			continue
+		}
+		style := "solid"
+		if edge.Kind == EdgeFallthrough {
+			style = "dashed"
+		}
+		fmt.Fprintf(&b, "  n%d -> n%d [style=%s];\n", edge.From, edge.To, style)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
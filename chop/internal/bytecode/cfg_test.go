@@ -0,0 +1,90 @@
+package bytecode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportCFGResolvesJumpiBranchAndFallthrough(t *testing.T) {
+	result, err := AnalyzeBytecodeFromBytes(conditionalBranch)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	cfg, err := ExportCFG(result)
+	if err != nil {
+		t.Fatalf("ExportCFG: %v", err)
+	}
+	if len(cfg.Nodes) != 3 {
+		t.Fatalf("got %d nodes, want 3", len(cfg.Nodes))
+	}
+
+	var jumpEdges, fallthroughEdges int
+	for _, edge := range cfg.Edges {
+		if edge.Unresolved {
+			t.Errorf("got an unresolved edge %+v, want the JUMPI's static target resolved", edge)
+		}
+		switch edge.Kind {
+		case EdgeJump:
+			jumpEdges++
+			if edge.From != 0 || cfg.Nodes[edge.To].StartPC != 8 {
+				t.Errorf("got jump edge %+v, want block 0 -> the block starting at PC 8", edge)
+			}
+		case EdgeFallthrough:
+			fallthroughEdges++
+		}
+	}
+	if jumpEdges != 1 {
+		t.Errorf("got %d jump edges, want 1", jumpEdges)
+	}
+	if fallthroughEdges != 2 {
+		t.Errorf("got %d fallthrough edges, want 2 (JUMPI's false branch, and block 1 into block 2)", fallthroughEdges)
+	}
+}
+
+func TestExportCFGMarksUnresolvedDynamicJump(t *testing.T) {
+	// JUMPDEST CALLER JUMP: the jump target comes from CALLER, not an
+	// immediately preceding PUSH, so it can't be resolved statically.
+	code := []byte{0x5b, 0x33, 0x56}
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	cfg, err := ExportCFG(result)
+	if err != nil {
+		t.Fatalf("ExportCFG: %v", err)
+	}
+	if len(cfg.Edges) != 1 || !cfg.Edges[0].Unresolved {
+		t.Fatalf("got edges %+v, want exactly one unresolved dynamic jump", cfg.Edges)
+	}
+	if cfg.Edges[0].To != -1 {
+		t.Errorf("got To %d for an unresolved edge, want -1", cfg.Edges[0].To)
+	}
+}
+
+func TestToDOTRendersUnresolvedJumpsDistinctly(t *testing.T) {
+	code := []byte{0x5b, 0x33, 0x56}
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+	cfg, err := ExportCFG(result)
+	if err != nil {
+		t.Fatalf("ExportCFG: %v", err)
+	}
+
+	dot := cfg.ToDOT()
+	if !strings.HasPrefix(dot, "digraph CFG {") {
+		t.Errorf("got %q, want a digraph header", dot)
+	}
+	if !strings.Contains(dot, "dynamic jump") || !strings.Contains(dot, "color=red") {
+		t.Errorf("got %q, want the unresolved jump called out distinctly", dot)
+	}
+}
+
+func TestExportCFGNilResultReturnsError(t *testing.T) {
+	if _, err := ExportCFG(nil); err == nil {
+		t.Error("want an error exporting the CFG of a nil disassembly")
+	}
+}
@@ -0,0 +1,51 @@
+package bytecode
+
+import "encoding/hex"
+
+// PushKind classifies what a PUSH instruction's immediate value most
+// likely represents, so a UI can colorize it distinctly from a plain
+// number.
+type PushKind string
+
+const (
+	// PushNumber is the default: an ordinary numeric literal.
+	PushNumber PushKind = "number"
+	// PushAddress is a PUSH20 immediate, the size of an Ethereum
+	// address.
+	PushAddress PushKind = "address"
+	// PushSelector is a PUSH4 immediate, the size of a function
+	// selector.
+	PushSelector PushKind = "selector"
+	// PushMask is a PUSH32 immediate matching a well-known bitmask or
+	// sentinel constant (max uint256, the address mask, etc.).
+	PushMask PushKind = "mask"
+)
+
+// knownMasks lists PUSH32 immediates (as hex, lowercase, no 0x prefix)
+// that are common constants rather than arbitrary numbers.
+var knownMasks = map[string]bool{
+	// max uint256 / -1 as two's complement: the all-ones word used for
+	// NOT masks and to represent -1.
+	"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff": true,
+	// the low-160-bits mask Solidity uses to truncate a word to an
+	// address.
+	"000000000000000000000000ffffffffffffffffffffffffffffffffffffffff": true,
+}
+
+// ClassifyPushValue reports what inst's immediate value most likely
+// represents: PUSH20 as an address, PUSH4 as a selector, PUSH32 as a
+// mask if it matches a well-known constant, and everything else as a
+// plain number.
+func ClassifyPushValue(inst Instruction) PushKind {
+	switch len(inst.Push) {
+	case 20:
+		return PushAddress
+	case 4:
+		return PushSelector
+	case 32:
+		if knownMasks[hex.EncodeToString(inst.Push)] {
+			return PushMask
+		}
+	}
+	return PushNumber
+}
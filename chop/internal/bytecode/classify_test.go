@@ -0,0 +1,35 @@
+package bytecode
+
+import "testing"
+
+func TestClassifyPushValuePush20IsAddress(t *testing.T) {
+	inst := Instruction{Push: make([]byte, 20)}
+	if got := ClassifyPushValue(inst); got != PushAddress {
+		t.Errorf("got %q, want %q", got, PushAddress)
+	}
+}
+
+func TestClassifyPushValuePush4IsSelector(t *testing.T) {
+	inst := Instruction{Push: []byte{0xa9, 0x05, 0x9c, 0xbb}}
+	if got := ClassifyPushValue(inst); got != PushSelector {
+		t.Errorf("got %q, want %q", got, PushSelector)
+	}
+}
+
+func TestClassifyPushValuePush32KnownMaskIsMask(t *testing.T) {
+	maxUint256 := make([]byte, 32)
+	for i := range maxUint256 {
+		maxUint256[i] = 0xff
+	}
+	inst := Instruction{Push: maxUint256}
+	if got := ClassifyPushValue(inst); got != PushMask {
+		t.Errorf("got %q, want %q", got, PushMask)
+	}
+}
+
+func TestClassifyPushValueOrdinaryPush1IsNumber(t *testing.T) {
+	inst := Instruction{Push: []byte{0x2a}}
+	if got := ClassifyPushValue(inst); got != PushNumber {
+		t.Errorf("got %q, want %q", got, PushNumber)
+	}
+}
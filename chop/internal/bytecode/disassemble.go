@@ -0,0 +1,141 @@
+package bytecode
+
+import "fmt"
+
+// pushBase is the byte value of PUSH0; PUSH1..PUSH32 follow at
+// pushBase+1..pushBase+32. Opcodes only lists a handful of opcodes by
+// name, so the disassembler decodes the PUSH family directly from the
+// byte range rather than depending on every PUSHn having a table
+// entry.
+const pushBase = 0x5f
+
+// Instruction is one decoded instruction in a disassembly: its
+// program counter, opcode, and (for PUSHn) the raw immediate bytes
+// that followed it.
+type Instruction struct {
+	PC     int
+	Opcode Opcode
+	Push   []byte // immediate bytes for PUSH1..PUSH32; nil otherwise
+}
+
+// isTerminator reports whether instructions of this opcode end a
+// basic block: control either leaves the block unconditionally (JUMP,
+// STOP, RETURN, REVERT, SELFDESTRUCT, invalid byte) or may leave it
+// (JUMPI).
+func (i Instruction) isTerminator() bool {
+	switch i.Opcode.Byte {
+	case 0x00, 0x56, 0x57, 0xf3, 0xfd, 0xff:
+		return true
+	}
+	return i.Opcode.Name == "" // undecoded byte halts execution
+}
+
+// BasicBlock is a maximal run of instructions with a single entry
+// point: it starts at PC 0, at a JUMPDEST, or right after a terminating
+// instruction, and ends at a terminating instruction or the
+// instruction right before the next block's start.
+type BasicBlock struct {
+	StartPC      int
+	EndPC        int // PC of the last byte of the block's last instruction
+	Instructions []Instruction
+}
+
+// DisassemblyResult is the output of analyzing a contract's bytecode.
+type DisassemblyResult struct {
+	Code         []byte
+	Instructions []Instruction
+	BasicBlocks  []BasicBlock
+	JumpDests    map[int]bool // PC -> valid JUMPDEST
+}
+
+// decodeOpcode looks up b in Opcodes, synthesizing an entry for the
+// PUSH opcodes the table doesn't list by name and for undecoded bytes
+// so callers always get a usable Instruction instead of a lookup
+// failure mid-disassembly.
+func decodeOpcode(b byte) Opcode {
+	if op, ok := Opcodes[b]; ok {
+		return op
+	}
+	if b > pushBase && b <= pushBase+32 {
+		n := int(b) - pushBase
+		return Opcode{Name: fmt.Sprintf("PUSH%d", n), Byte: b, StackOut: 1}
+	}
+	return Opcode{Byte: b} // Name left empty: marks an undecoded byte
+}
+
+// AnalyzeBytecodeFromBytes decodes raw bytecode into a linear
+// instruction stream and groups it into basic blocks. PUSH immediates
+// are skipped over when scanning for the next opcode, matching real
+// EVM semantics where a PUSH's immediate bytes are data, not
+// instructions — so a 0x5b inside a PUSH's immediate isn't a valid
+// jump destination.
+func AnalyzeBytecodeFromBytes(code []byte) (*DisassemblyResult, error) {
+	if len(code) == 0 {
+		return nil, fmt.Errorf("bytecode: cannot analyze empty code")
+	}
+
+	result := &DisassemblyResult{Code: code, JumpDests: make(map[int]bool)}
+
+	for pc := 0; pc < len(code); {
+		b := code[pc]
+		op := decodeOpcode(b)
+		inst := Instruction{PC: pc, Opcode: op}
+
+		if b > pushBase && b <= pushBase+32 {
+			n := int(b) - pushBase
+			end := pc + 1 + n
+			if end > len(code) {
+				end = len(code)
+			}
+			inst.Push = append([]byte(nil), code[pc+1:end]...)
+			pc = end
+		} else {
+			pc++
+		}
+
+		if b == 0x5b {
+			result.JumpDests[inst.PC] = true
+		}
+		result.Instructions = append(result.Instructions, inst)
+	}
+
+	result.BasicBlocks = splitBasicBlocks(result.Instructions, result.JumpDests)
+	return result, nil
+}
+
+// splitBasicBlocks groups a linear instruction stream into basic
+// blocks: a new block starts at instruction 0, at every JUMPDEST, and
+// right after every terminator, and the current block ends at a
+// terminator or right before the next block's start.
+func splitBasicBlocks(instructions []Instruction, jumpDests map[int]bool) []BasicBlock {
+	var blocks []BasicBlock
+	var current BasicBlock
+	started := false
+
+	flush := func() {
+		if started && len(current.Instructions) > 0 {
+			last := current.Instructions[len(current.Instructions)-1]
+			current.EndPC = last.PC + len(last.Push)
+			blocks = append(blocks, current)
+		}
+	}
+
+	for _, inst := range instructions {
+		startsNewBlock := jumpDests[inst.PC] && len(current.Instructions) > 0
+		if startsNewBlock {
+			flush()
+			current = BasicBlock{}
+		}
+		if len(current.Instructions) == 0 {
+			current.StartPC = inst.PC
+		}
+		current.Instructions = append(current.Instructions, inst)
+		started = true
+		if inst.isTerminator() {
+			flush()
+			current = BasicBlock{}
+		}
+	}
+	flush()
+	return blocks
+}
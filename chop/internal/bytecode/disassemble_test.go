@@ -0,0 +1,62 @@
+package bytecode
+
+import "testing"
+
+// conditionalBranch is: PUSH1 0x00 CALLDATALOAD PUSH1 0x08 JUMPI
+// (block 1), PUSH1 0x01 (block 2, the JUMPI's fallthrough), JUMPDEST
+// STOP (block 3, the JUMPI target, also reached by falling through
+// block 2), encoded so the JUMPI at PC 5 jumps to the JUMPDEST at PC 8.
+var conditionalBranch = []byte{
+	0x60, 0x00, // PC0 PUSH1 0x00
+	0x35,       // PC2 CALLDATALOAD
+	0x60, 0x08, // PC3 PUSH1 0x08
+	0x57,       // PC5 JUMPI -> PC8
+	0x60, 0x01, // PC6 PUSH1 0x01 (fallthrough block)
+	0x5b, // PC8 JUMPDEST
+	0x00, // PC9 STOP
+}
+
+func TestAnalyzeBytecodeFromBytesSplitsBasicBlocks(t *testing.T) {
+	result, err := AnalyzeBytecodeFromBytes(conditionalBranch)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	if !result.JumpDests[8] {
+		t.Errorf("want PC 8 recorded as a JUMPDEST")
+	}
+	if len(result.BasicBlocks) != 3 {
+		t.Fatalf("got %d basic blocks, want 3: %+v", len(result.BasicBlocks), result.BasicBlocks)
+	}
+	if result.BasicBlocks[0].StartPC != 0 {
+		t.Errorf("got first block start %d, want 0", result.BasicBlocks[0].StartPC)
+	}
+	if result.BasicBlocks[1].StartPC != 6 {
+		t.Errorf("got second block start %d, want 6", result.BasicBlocks[1].StartPC)
+	}
+	if result.BasicBlocks[2].StartPC != 8 {
+		t.Errorf("got third block start %d, want 8", result.BasicBlocks[2].StartPC)
+	}
+}
+
+func TestAnalyzeBytecodeFromBytesRejectsEmpty(t *testing.T) {
+	if _, err := AnalyzeBytecodeFromBytes(nil); err == nil {
+		t.Error("want an error analyzing empty bytecode")
+	}
+}
+
+func TestAnalyzeBytecodeFromBytesSkipsPushImmediates(t *testing.T) {
+	// PUSH1 0x5b (looks like a JUMPDEST byte, but it's PUSH1's
+	// immediate, not an opcode) followed by STOP.
+	code := []byte{0x60, 0x5b, 0x00}
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+	if result.JumpDests[1] {
+		t.Error("PUSH1's immediate byte was misread as a JUMPDEST")
+	}
+	if len(result.Instructions) != 2 {
+		t.Fatalf("got %d instructions, want 2 (PUSH1, STOP)", len(result.Instructions))
+	}
+}
@@ -0,0 +1,51 @@
+// Package bytecode analyzes raw EVM bytecode and holds the opcode
+// reference table used by both the CLI and the TUI.
+package bytecode
+
+// Fork identifies an Ethereum hardfork for opcode gas/availability
+// purposes.
+type Fork string
+
+// Forks oldest to newest. Gas costs and opcode availability are
+// resolved relative to this ordering.
+const (
+	Frontier       Fork = "frontier"
+	Tangerine      Fork = "tangerine-whistle"
+	Byzantium      Fork = "byzantium"
+	Constantinople Fork = "constantinople"
+	Istanbul       Fork = "istanbul"
+	Berlin         Fork = "berlin"
+	London         Fork = "london"
+	Merge          Fork = "merge"
+	Shanghai       Fork = "shanghai"
+	Cancun         Fork = "cancun"
+)
+
+// forkOrder lists every known fork oldest to newest.
+var forkOrder = []Fork{
+	Frontier, Tangerine, Byzantium, Constantinople, Istanbul,
+	Berlin, London, Merge, Shanghai, Cancun,
+}
+
+// KnownForks returns every fork this table recognizes, oldest to
+// newest.
+func KnownForks() []Fork {
+	forks := make([]Fork, len(forkOrder))
+	copy(forks, forkOrder)
+	return forks
+}
+
+func forkIndex(f Fork) int {
+	for i, x := range forkOrder {
+		if x == f {
+			return i
+		}
+	}
+	// Unknown fork: treat as newest so unrecognized fork names don't
+	// hide opcodes/costs behind a comparison that can never pass.
+	return len(forkOrder) - 1
+}
+
+// LatestFork is the newest fork this table knows about, used as the
+// default when none is specified.
+const LatestFork = Cancun
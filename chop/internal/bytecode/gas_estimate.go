@@ -0,0 +1,67 @@
+package bytecode
+
+import "fmt"
+
+// dynamicGasOpcodes names opcodes whose real cost depends on runtime
+// state — memory expansion, cold/warm access, call data size, the
+// number of bytes hashed or logged — rather than being the fixed
+// per-fork constant GasAt looks up.
+var dynamicGasOpcodes = map[string]bool{
+	"SHA3": true, "SLOAD": true, "SSTORE": true, "BALANCE": true,
+	"EXTCODESIZE": true, "EXTCODECOPY": true, "EXTCODEHASH": true,
+	"CALLDATACOPY": true, "CODECOPY": true, "RETURNDATACOPY": true,
+	"LOG0": true, "LOG1": true, "LOG2": true, "LOG3": true, "LOG4": true,
+	"CREATE": true, "CREATE2": true, "CALL": true, "CALLCODE": true,
+	"DELEGATECALL": true, "STATICCALL": true, "SELFDESTRUCT": true,
+	"EXP": true,
+}
+
+// CalculateBlockGas sums the static, per-fork gas cost of every
+// instruction in block, ignoring any dynamic component opcodes like
+// SSTORE or CALL actually incur at runtime.
+func CalculateBlockGas(block BasicBlock, fork Fork) uint64 {
+	var total uint64
+	for _, inst := range block.Instructions {
+		total += uint64(inst.Opcode.GasAt(fork))
+	}
+	return total
+}
+
+// GasEstimate is the result of EstimateStaticGas: a rough upper bound
+// on deployment/execution cost, plus the distinct dynamic-gas opcodes
+// that bound doesn't fully account for.
+type GasEstimate struct {
+	// Total is the sum of every instruction's static gas cost "if
+	// every instruction executed once" — not a true worst case, since
+	// it doesn't account for loops, and not a lower bound either,
+	// since unreachable blocks are counted too.
+	Total uint64
+	// DynamicGasOpcodes lists, in encounter order, the distinct
+	// opcodes found whose real cost Total couldn't fully account for.
+	DynamicGasOpcodes []string
+}
+
+// EstimateStaticGas sums CalculateBlockGas across every basic block in
+// result for a rough "if every instruction executed once" upper-bound
+// gas figure, at the latest known fork's costs. Instructions with a
+// dynamic gas component (SSTORE, CALL, SHA3, ...) are counted at their
+// static floor only; EstimateStaticGas also returns which of those
+// opcodes it saw, so the estimate's limitations are explicit.
+func EstimateStaticGas(result *DisassemblyResult) (GasEstimate, error) {
+	if result == nil {
+		return GasEstimate{}, fmt.Errorf("bytecode: cannot estimate gas of a nil disassembly")
+	}
+
+	var estimate GasEstimate
+	seen := make(map[string]bool)
+	for _, block := range result.BasicBlocks {
+		estimate.Total += CalculateBlockGas(block, LatestFork)
+		for _, inst := range block.Instructions {
+			if dynamicGasOpcodes[inst.Opcode.Name] && !seen[inst.Opcode.Name] {
+				seen[inst.Opcode.Name] = true
+				estimate.DynamicGasOpcodes = append(estimate.DynamicGasOpcodes, inst.Opcode.Name)
+			}
+		}
+	}
+	return estimate, nil
+}
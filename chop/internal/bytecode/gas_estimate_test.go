@@ -0,0 +1,49 @@
+package bytecode
+
+import "testing"
+
+func TestEstimateStaticGasSumsStaticCostsAndFlagsDynamicOpcodes(t *testing.T) {
+	// PUSH1 0x01 PUSH1 0x02 ADD SLOAD STOP: ADD and PUSH1 are pure
+	// static cost; SLOAD has a dynamic component.
+	code := []byte{0x60, 0x01, 0x60, 0x02, 0x01, 0x54, 0x00}
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	estimate, err := EstimateStaticGas(result)
+	if err != nil {
+		t.Fatalf("EstimateStaticGas: %v", err)
+	}
+
+	wantStatic := uint64(3 + 3 + 3) // two PUSH1 + ADD, at their Frontier-unchanged costs
+	sloadCost := uint64(Opcodes[0x54].GasAt(LatestFork))
+	if estimate.Total != wantStatic+sloadCost {
+		t.Errorf("got total %d, want %d", estimate.Total, wantStatic+sloadCost)
+	}
+	if len(estimate.DynamicGasOpcodes) != 1 || estimate.DynamicGasOpcodes[0] != "SLOAD" {
+		t.Errorf("got dynamic opcodes %v, want [SLOAD]", estimate.DynamicGasOpcodes)
+	}
+}
+
+func TestEstimateStaticGasWithNoDynamicOpcodesReportsNone(t *testing.T) {
+	code := []byte{0x60, 0x01, 0x60, 0x02, 0x01, 0x00} // PUSH1 PUSH1 ADD STOP
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	estimate, err := EstimateStaticGas(result)
+	if err != nil {
+		t.Fatalf("EstimateStaticGas: %v", err)
+	}
+	if len(estimate.DynamicGasOpcodes) != 0 {
+		t.Errorf("got dynamic opcodes %v, want none", estimate.DynamicGasOpcodes)
+	}
+}
+
+func TestEstimateStaticGasNilResultReturnsError(t *testing.T) {
+	if _, err := EstimateStaticGas(nil); err == nil {
+		t.Error("want an error estimating gas of a nil disassembly")
+	}
+}
@@ -0,0 +1,134 @@
+package bytecode
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GasCost is an opcode's static gas cost as of a given fork. Opcodes
+// whose cost changed across forks (e.g. SLOAD) carry one entry per
+// fork that changed it.
+type GasCost struct {
+	Fork Fork
+	Gas  int
+}
+
+// Opcode describes one EVM instruction for the reference lookup.
+type Opcode struct {
+	Name        string
+	Byte        byte
+	StackIn     int
+	StackOut    int
+	Description string
+	Since       Fork // fork that introduced this opcode
+	EIP         string
+	GasHistory  []GasCost // oldest first
+}
+
+// GasAt returns the opcode's static gas cost as of fork, i.e. the most
+// recent GasHistory entry at or before fork.
+func (o Opcode) GasAt(fork Fork) int {
+	cost := 0
+	for _, g := range o.GasHistory {
+		if forkIndex(g.Fork) <= forkIndex(fork) {
+			cost = g.Gas
+		}
+	}
+	return cost
+}
+
+// AvailableAt reports whether the opcode exists under fork.
+func (o Opcode) AvailableAt(fork Fork) bool {
+	return forkIndex(fork) >= forkIndex(o.Since)
+}
+
+// Opcodes is the reference table, keyed by opcode byte. It is not yet
+// exhaustive over all 256 byte values; unlisted bytes are invalid
+// opcodes.
+var Opcodes = map[byte]Opcode{
+	0x00: {Name: "STOP", Byte: 0x00, Description: "Halts execution.", Since: Frontier, GasHistory: []GasCost{{Frontier, 0}}},
+	0x01: {Name: "ADD", Byte: 0x01, StackIn: 2, StackOut: 1, Description: "Addition operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x02: {Name: "MUL", Byte: 0x02, StackIn: 2, StackOut: 1, Description: "Multiplication operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 5}}},
+	0x03: {Name: "SUB", Byte: 0x03, StackIn: 2, StackOut: 1, Description: "Subtraction operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x04: {Name: "DIV", Byte: 0x04, StackIn: 2, StackOut: 1, Description: "Integer division operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 5}}},
+	0x06: {Name: "MOD", Byte: 0x06, StackIn: 2, StackOut: 1, Description: "Modulo remainder operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 5}}},
+	0x0a: {Name: "EXP", Byte: 0x0a, StackIn: 2, StackOut: 1, Description: "Exponential operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 10}}},
+	0x10: {Name: "LT", Byte: 0x10, StackIn: 2, StackOut: 1, Description: "Less-than comparison.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x11: {Name: "GT", Byte: 0x11, StackIn: 2, StackOut: 1, Description: "Greater-than comparison.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x14: {Name: "EQ", Byte: 0x14, StackIn: 2, StackOut: 1, Description: "Equality comparison.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x15: {Name: "ISZERO", Byte: 0x15, StackIn: 1, StackOut: 1, Description: "Simple NOT-zero check.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x16: {Name: "AND", Byte: 0x16, StackIn: 2, StackOut: 1, Description: "Bitwise AND operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x17: {Name: "OR", Byte: 0x17, StackIn: 2, StackOut: 1, Description: "Bitwise OR operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x18: {Name: "XOR", Byte: 0x18, StackIn: 2, StackOut: 1, Description: "Bitwise XOR operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x19: {Name: "NOT", Byte: 0x19, StackIn: 1, StackOut: 1, Description: "Bitwise NOT operation.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x1b: {Name: "SHL", Byte: 0x1b, StackIn: 2, StackOut: 1, Description: "Shift left.", Since: Constantinople, EIP: "EIP-145", GasHistory: []GasCost{{Constantinople, 3}}},
+	0x1c: {Name: "SHR", Byte: 0x1c, StackIn: 2, StackOut: 1, Description: "Logical shift right.", Since: Constantinople, EIP: "EIP-145", GasHistory: []GasCost{{Constantinople, 3}}},
+	0x20: {Name: "SHA3", Byte: 0x20, StackIn: 2, StackOut: 1, Description: "Compute Keccak-256 hash.", Since: Frontier, GasHistory: []GasCost{{Frontier, 30}}},
+	0x30: {Name: "ADDRESS", Byte: 0x30, StackOut: 1, Description: "Get address of currently executing account.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x31: {Name: "BALANCE", Byte: 0x31, StackIn: 1, StackOut: 1, Description: "Get balance of the given account.", Since: Frontier, EIP: "EIP-2929", GasHistory: []GasCost{{Frontier, 20}, {Istanbul, 700}, {Berlin, 100}}},
+	0x33: {Name: "CALLER", Byte: 0x33, StackOut: 1, Description: "Get caller address.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x34: {Name: "CALLVALUE", Byte: 0x34, StackOut: 1, Description: "Get deposited value by the instruction/transaction responsible for this execution.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x35: {Name: "CALLDATALOAD", Byte: 0x35, StackIn: 1, StackOut: 1, Description: "Get input data of current environment.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x36: {Name: "CALLDATASIZE", Byte: 0x36, StackOut: 1, Description: "Get size of input data in current environment.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x50: {Name: "POP", Byte: 0x50, StackIn: 1, Description: "Remove item from stack.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x51: {Name: "MLOAD", Byte: 0x51, StackIn: 1, StackOut: 1, Description: "Load word from memory.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x52: {Name: "MSTORE", Byte: 0x52, StackIn: 2, Description: "Save word to memory.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x54: {Name: "SLOAD", Byte: 0x54, StackIn: 1, StackOut: 1, Description: "Load word from storage.", Since: Frontier, EIP: "EIP-2929", GasHistory: []GasCost{{Frontier, 50}, {Istanbul, 800}, {Berlin, 100}}},
+	0x55: {Name: "SSTORE", Byte: 0x55, StackIn: 2, Description: "Save word to storage.", Since: Frontier, EIP: "EIP-2929", GasHistory: []GasCost{{Frontier, 20000}, {Berlin, 20000}}},
+	0x56: {Name: "JUMP", Byte: 0x56, StackIn: 1, Description: "Alter the program counter.", Since: Frontier, GasHistory: []GasCost{{Frontier, 8}}},
+	0x57: {Name: "JUMPI", Byte: 0x57, StackIn: 2, Description: "Conditionally alter the program counter.", Since: Frontier, GasHistory: []GasCost{{Frontier, 10}}},
+	0x58: {Name: "PC", Byte: 0x58, StackOut: 1, Description: "Get the value of the program counter prior to the increment.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x59: {Name: "MSIZE", Byte: 0x59, StackOut: 1, Description: "Get the size of active memory in bytes.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x5a: {Name: "GAS", Byte: 0x5a, StackOut: 1, Description: "Get the amount of available gas, including the corresponding reduction for the cost of this instruction.", Since: Frontier, GasHistory: []GasCost{{Frontier, 2}}},
+	0x5b: {Name: "JUMPDEST", Byte: 0x5b, Description: "Mark a valid destination for jumps.", Since: Frontier, GasHistory: []GasCost{{Frontier, 1}}},
+	0x5c: {Name: "TLOAD", Byte: 0x5c, StackIn: 1, StackOut: 1, Description: "Load word from transient storage.", Since: Cancun, EIP: "EIP-1153", GasHistory: []GasCost{{Cancun, 100}}},
+	0x5d: {Name: "TSTORE", Byte: 0x5d, StackIn: 2, Description: "Save word to transient storage.", Since: Cancun, EIP: "EIP-1153", GasHistory: []GasCost{{Cancun, 100}}},
+	0x5f: {Name: "PUSH0", Byte: 0x5f, StackOut: 1, Description: "Place the value 0 on the stack.", Since: Shanghai, EIP: "EIP-3855", GasHistory: []GasCost{{Shanghai, 2}}},
+	0x60: {Name: "PUSH1", Byte: 0x60, StackOut: 1, Description: "Place 1 byte item on stack.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x80: {Name: "DUP1", Byte: 0x80, StackIn: 1, StackOut: 2, Description: "Duplicate 1st stack item.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0x90: {Name: "SWAP1", Byte: 0x90, StackIn: 2, StackOut: 2, Description: "Exchange 1st and 2nd stack items.", Since: Frontier, GasHistory: []GasCost{{Frontier, 3}}},
+	0xa0: {Name: "LOG0", Byte: 0xa0, StackIn: 2, Description: "Append log record with no topics.", Since: Frontier, GasHistory: []GasCost{{Frontier, 375}}},
+	0xa1: {Name: "LOG1", Byte: 0xa1, StackIn: 3, Description: "Append log record with one topic.", Since: Frontier, GasHistory: []GasCost{{Frontier, 750}}},
+	0xf0: {Name: "CREATE", Byte: 0xf0, StackIn: 3, StackOut: 1, Description: "Create a new account with associated code.", Since: Frontier, GasHistory: []GasCost{{Frontier, 32000}}},
+	0xf1: {Name: "CALL", Byte: 0xf1, StackIn: 7, StackOut: 1, Description: "Message-call into an account.", Since: Frontier, EIP: "EIP-2929", GasHistory: []GasCost{{Frontier, 40}, {Istanbul, 700}, {Berlin, 100}}},
+	0xf3: {Name: "RETURN", Byte: 0xf3, StackIn: 2, Description: "Halt execution returning output data.", Since: Frontier, GasHistory: []GasCost{{Frontier, 0}}},
+	0xf4: {Name: "DELEGATECALL", Byte: 0xf4, StackIn: 6, StackOut: 1, Description: "Message-call into this account with an alternative account's code, but persisting the current values for sender and value.", Since: Byzantium, EIP: "EIP-2929", GasHistory: []GasCost{{Byzantium, 40}, {Istanbul, 700}, {Berlin, 100}}},
+	0xf5: {Name: "CREATE2", Byte: 0xf5, StackIn: 4, StackOut: 1, Description: "Create a new account with associated code at a predictable address.", Since: Constantinople, EIP: "EIP-1014", GasHistory: []GasCost{{Constantinople, 32000}}},
+	0xfa: {Name: "STATICCALL", Byte: 0xfa, StackIn: 6, StackOut: 1, Description: "Static message-call into an account.", Since: Byzantium, EIP: "EIP-2929", GasHistory: []GasCost{{Byzantium, 40}, {Istanbul, 700}, {Berlin, 100}}},
+	0xfd: {Name: "REVERT", Byte: 0xfd, StackIn: 2, Description: "Halt execution reverting state changes but returning data and remaining gas.", Since: Byzantium, EIP: "EIP-140", GasHistory: []GasCost{{Byzantium, 0}}},
+	0xff: {Name: "SELFDESTRUCT", Byte: 0xff, StackIn: 1, Description: "Halt execution and register account for later deletion.", Since: Frontier, EIP: "EIP-2929", GasHistory: []GasCost{{Frontier, 0}, {Berlin, 5000}}},
+}
+
+// byName is a precomputed reverse index of Opcodes for name lookups.
+var byName = func() map[string]Opcode {
+	m := make(map[string]Opcode, len(Opcodes))
+	for _, op := range Opcodes {
+		m[op.Name] = op
+	}
+	return m
+}()
+
+// ByName returns the opcode with the given mnemonic (case-insensitive),
+// or false if it is not in the table.
+func ByName(name string) (Opcode, bool) {
+	op, ok := byName[strings.ToUpper(name)]
+	return op, ok
+}
+
+// Lookup resolves a CLI/TUI argument that may be either a mnemonic
+// ("PUSH1") or a hex byte value ("0x60" or "60").
+func Lookup(arg string) (Opcode, error) {
+	if op, ok := ByName(arg); ok {
+		return op, nil
+	}
+	n, err := strconv.ParseUint(strings.TrimPrefix(arg, "0x"), 16, 8)
+	if err != nil {
+		return Opcode{}, fmt.Errorf("unknown opcode %q", arg)
+	}
+	op, ok := Opcodes[byte(n)]
+	if !ok {
+		return Opcode{}, fmt.Errorf("unknown opcode byte 0x%02x", n)
+	}
+	return op, nil
+}
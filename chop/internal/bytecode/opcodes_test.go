@@ -0,0 +1,52 @@
+package bytecode
+
+import "testing"
+
+func TestLookupByName(t *testing.T) {
+	op, err := Lookup("push1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if op.Name != "PUSH1" || op.Byte != 0x60 {
+		t.Errorf("got %+v", op)
+	}
+}
+
+func TestLookupByHex(t *testing.T) {
+	op, err := Lookup("0x60")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if op.Name != "PUSH1" {
+		t.Errorf("got %+v", op)
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	if _, err := Lookup("NOTANOPCODE"); err == nil {
+		t.Error("want error for unknown mnemonic")
+	}
+}
+
+func TestGasAtReflectsRepricing(t *testing.T) {
+	sload := Opcodes[0x54]
+	if g := sload.GasAt(Frontier); g != 50 {
+		t.Errorf("Frontier SLOAD gas = %d, want 50", g)
+	}
+	if g := sload.GasAt(Istanbul); g != 800 {
+		t.Errorf("Istanbul SLOAD gas = %d, want 800", g)
+	}
+	if g := sload.GasAt(Berlin); g != 100 {
+		t.Errorf("Berlin SLOAD gas = %d, want 100", g)
+	}
+}
+
+func TestAvailableAt(t *testing.T) {
+	push0 := Opcodes[0x5f]
+	if push0.AvailableAt(London) {
+		t.Error("PUSH0 should not be available before Shanghai")
+	}
+	if !push0.AvailableAt(Shanghai) {
+		t.Error("PUSH0 should be available at Shanghai")
+	}
+}
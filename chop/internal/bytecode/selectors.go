@@ -0,0 +1,75 @@
+package bytecode
+
+// Selector is a 4-byte function selector a contract's dispatcher
+// branches on, plus the PC execution jumps to when calldata matches
+// it. Signature is the resolved human-readable signature (e.g.
+// "transfer(address,uint256)") when the selector is recognized, and
+// empty otherwise.
+type Selector struct {
+	Bytes      [4]byte
+	TargetPC   int
+	Unresolved bool // the dispatch target couldn't be resolved statically
+	Signature  string
+}
+
+// knownSelectors resolves a handful of widely used ERC-20 selectors
+// against their signatures, since these show up in the overwhelming
+// majority of dispatched contracts.
+var knownSelectors = map[[4]byte]string{
+	{0xa9, 0x05, 0x9c, 0xbb}: "transfer(address,uint256)",
+	{0x09, 0x5e, 0xa7, 0xb3}: "approve(address,uint256)",
+	{0x23, 0xb8, 0x72, 0xdd}: "transferFrom(address,address,uint256)",
+	{0x70, 0xa0, 0x82, 0x31}: "balanceOf(address)",
+	{0x18, 0x16, 0x0d, 0xdd}: "totalSupply()",
+	{0xdd, 0x62, 0xed, 0x3e}: "allowance(address,address)",
+}
+
+// DetectSelectors scans result for the common Solidity dispatcher
+// pattern — a PUSH4 selector compared with EQ and branched on with
+// JUMPI within the same basic block — and returns each selector found
+// along with the PC it dispatches to. Selectors matching
+// knownSelectors are annotated with their signature.
+func DetectSelectors(result *DisassemblyResult) []Selector {
+	var selectors []Selector
+	for _, block := range result.BasicBlocks {
+		if len(block.Instructions) == 0 {
+			continue
+		}
+		last := block.Instructions[len(block.Instructions)-1]
+		if last.Opcode.Byte != 0x57 { // JUMPI
+			continue
+		}
+
+		for i, inst := range block.Instructions {
+			if len(inst.Push) != 4 {
+				continue
+			}
+			if !followedByEQ(block.Instructions[i+1:]) {
+				continue
+			}
+
+			sel := Selector{}
+			copy(sel.Bytes[:], inst.Push)
+			if target, ok := staticJumpTarget(block); ok {
+				sel.TargetPC = target
+			} else {
+				sel.Unresolved = true
+			}
+			sel.Signature = knownSelectors[sel.Bytes]
+			selectors = append(selectors, sel)
+		}
+	}
+	return selectors
+}
+
+// followedByEQ reports whether instructions contains an EQ, meaning a
+// preceding PUSH4 is being compared for equality (the selector check
+// half of the dispatcher pattern).
+func followedByEQ(instructions []Instruction) bool {
+	for _, inst := range instructions {
+		if inst.Opcode.Byte == 0x14 { // EQ
+			return true
+		}
+	}
+	return false
+}
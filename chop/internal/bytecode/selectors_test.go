@@ -0,0 +1,78 @@
+package bytecode
+
+import "testing"
+
+// twoFunctionDispatcher encodes a two-arm Solidity-style selector
+// dispatcher: each arm is DUP1 PUSH4 <selector> EQ PUSH2 <target>
+// JUMPI, falling through to a STOP stub if neither matches, with
+// each arm's JUMPDEST target placed after the stub.
+var twoFunctionDispatcher = []byte{
+	0x80, 0x63, 0xaa, 0xbb, 0xcc, 0xdd, 0x14, 0x61, 0x00, 0x17, 0x57, // PC0-10: arm 1 -> PC23
+	0x80, 0x63, 0x11, 0x22, 0x33, 0x44, 0x14, 0x61, 0x00, 0x19, 0x57, // PC11-21: arm 2 -> PC25
+	0x00,       // PC22: STOP (fallback)
+	0x5b, 0x00, // PC23-24: JUMPDEST, STOP (arm 1 target)
+	0x5b, 0x00, // PC25-26: JUMPDEST, STOP (arm 2 target)
+}
+
+func TestDetectSelectorsFindsBothDispatcherArms(t *testing.T) {
+	result, err := AnalyzeBytecodeFromBytes(twoFunctionDispatcher)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	selectors := DetectSelectors(result)
+	if len(selectors) != 2 {
+		t.Fatalf("got %d selectors, want 2: %+v", len(selectors), selectors)
+	}
+
+	if got := selectors[0].Bytes; got != [4]byte{0xaa, 0xbb, 0xcc, 0xdd} {
+		t.Errorf("got first selector %x, want aabbccdd", got)
+	}
+	if selectors[0].TargetPC != 23 || selectors[0].Unresolved {
+		t.Errorf("got first selector target %d (unresolved=%v), want 23", selectors[0].TargetPC, selectors[0].Unresolved)
+	}
+
+	if got := selectors[1].Bytes; got != [4]byte{0x11, 0x22, 0x33, 0x44} {
+		t.Errorf("got second selector %x, want 11223344", got)
+	}
+	if selectors[1].TargetPC != 25 || selectors[1].Unresolved {
+		t.Errorf("got second selector target %d (unresolved=%v), want 25", selectors[1].TargetPC, selectors[1].Unresolved)
+	}
+}
+
+func TestDetectSelectorsResolvesKnownSignature(t *testing.T) {
+	code := []byte{
+		0x80,                         // PC0: DUP1
+		0x63, 0xa9, 0x05, 0x9c, 0xbb, // PC1: PUSH4 transfer(address,uint256)
+		0x14,             // PC6: EQ
+		0x61, 0x00, 0x0c, // PC7: PUSH2 -> PC12
+		0x57, // PC10: JUMPI
+		0x00, // PC11: STOP fallback
+		0x5b, // PC12: JUMPDEST
+		0x00, // PC13: STOP
+	}
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+
+	selectors := DetectSelectors(result)
+	if len(selectors) != 1 {
+		t.Fatalf("got %d selectors, want 1: %+v", len(selectors), selectors)
+	}
+	if selectors[0].Signature != "transfer(address,uint256)" {
+		t.Errorf("got signature %q, want transfer(address,uint256)", selectors[0].Signature)
+	}
+}
+
+func TestDetectSelectorsIgnoresNonDispatcherPush4(t *testing.T) {
+	// A PUSH4 that's never compared with EQ isn't a dispatcher arm.
+	code := []byte{0x63, 0x01, 0x02, 0x03, 0x04, 0x00}
+	result, err := AnalyzeBytecodeFromBytes(code)
+	if err != nil {
+		t.Fatalf("AnalyzeBytecodeFromBytes: %v", err)
+	}
+	if selectors := DetectSelectors(result); len(selectors) != 0 {
+		t.Errorf("got %d selectors, want 0", len(selectors))
+	}
+}
@@ -0,0 +1,112 @@
+// Package chains holds a small built-in registry of well-known EVM
+// chains so chop can resolve a chain ID or short name to sensible
+// defaults (native currency, a public RPC URL for forking) instead of
+// requiring every individual flag to be set by hand.
+package chains
+
+// NativeCurrency describes a chain's native asset, needed to display
+// balances correctly on chains that aren't 18-decimal ether (e.g.
+// Alpen's 8-decimal sBTC).
+type NativeCurrency struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// Chain is one entry in the registry: enough to seed chop's chain
+// context (ChainID, native currency decimals) and, where available, a
+// default RPC URL to fork from.
+type Chain struct {
+	ID             uint64
+	Name           string
+	ShortName      string
+	NativeCurrency NativeCurrency
+	// RPCURL is a public endpoint suitable for `chop serve --fork-url`.
+	// Empty means forking this chain needs an explicit --fork-url.
+	RPCURL string
+}
+
+// registry lists the chains chop knows about out of the box. It isn't
+// meant to be exhaustive, just cover chains chop's users actually
+// work against; add to it as needed.
+var registry = []Chain{
+	{
+		ID:             1,
+		Name:           "Ethereum Mainnet",
+		ShortName:      "eth",
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPCURL:         "https://eth.llamarpc.com",
+	},
+	{
+		ID:             10,
+		Name:           "OP Mainnet",
+		ShortName:      "optimism",
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPCURL:         "https://mainnet.optimism.io",
+	},
+	{
+		ID:             137,
+		Name:           "Polygon",
+		ShortName:      "polygon",
+		NativeCurrency: NativeCurrency{Name: "POL", Symbol: "POL", Decimals: 18},
+		RPCURL:         "https://polygon-rpc.com",
+	},
+	{
+		ID:             2020,
+		Name:           "Ronin Mainnet",
+		ShortName:      "ronin",
+		NativeCurrency: NativeCurrency{Name: "Ronin", Symbol: "RON", Decimals: 18},
+		RPCURL:         "https://api.roninchain.com/rpc",
+	},
+	{
+		ID:             2892,
+		Name:           "Alpen Testnet",
+		ShortName:      "alpen",
+		NativeCurrency: NativeCurrency{Name: "Signet Bitcoin", Symbol: "sBTC", Decimals: 8},
+	},
+	{
+		ID:             8453,
+		Name:           "Base",
+		ShortName:      "base",
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPCURL:         "https://mainnet.base.org",
+	},
+	{
+		ID:             42161,
+		Name:           "Arbitrum One",
+		ShortName:      "arbitrum",
+		NativeCurrency: NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RPCURL:         "https://arb1.arbitrum.io/rpc",
+	},
+}
+
+// AllChains returns every chain in the registry, in the order they're
+// declared. The returned slice is a copy; mutating it doesn't affect
+// the registry.
+func AllChains() []Chain {
+	out := make([]Chain, len(registry))
+	copy(out, registry)
+	return out
+}
+
+// GetChainByID returns the registry entry for id, or false if id
+// isn't one chop knows about.
+func GetChainByID(id uint64) (Chain, bool) {
+	for _, c := range registry {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return Chain{}, false
+}
+
+// GetChainByShortName returns the registry entry whose ShortName
+// matches name exactly, or false if none does.
+func GetChainByShortName(name string) (Chain, bool) {
+	for _, c := range registry {
+		if c.ShortName == name {
+			return c, true
+		}
+	}
+	return Chain{}, false
+}
@@ -0,0 +1,45 @@
+package chains
+
+import "testing"
+
+func TestGetChainByIDFindsRonin(t *testing.T) {
+	c, ok := GetChainByID(2020)
+	if !ok {
+		t.Fatal("want chain 2020 to be found")
+	}
+	if c.ShortName != "ronin" {
+		t.Errorf("got short name %q, want ronin", c.ShortName)
+	}
+}
+
+func TestGetChainByShortNameFindsRonin(t *testing.T) {
+	c, ok := GetChainByShortName("ronin")
+	if !ok {
+		t.Fatal("want \"ronin\" to be found")
+	}
+	if c.ID != 2020 {
+		t.Errorf("got ID %d, want 2020", c.ID)
+	}
+}
+
+func TestGetChainByIDReportsUnknownChains(t *testing.T) {
+	if _, ok := GetChainByID(999_999); ok {
+		t.Error("want an unknown chain ID to report not found")
+	}
+}
+
+func TestGetChainByShortNameReportsUnknownChains(t *testing.T) {
+	if _, ok := GetChainByShortName("not-a-real-chain"); ok {
+		t.Error("want an unknown short name to report not found")
+	}
+}
+
+func TestAlpenUsesEightDecimalNativeCurrency(t *testing.T) {
+	c, ok := GetChainByID(2892)
+	if !ok {
+		t.Fatal("want chain 2892 (Alpen) to be found")
+	}
+	if c.NativeCurrency.Decimals != 8 {
+		t.Errorf("got %d decimals, want 8", c.NativeCurrency.Decimals)
+	}
+}
@@ -0,0 +1,427 @@
+// Package config loads and saves chop's user preferences.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultTruncateLength is how many hex characters of a long byte
+// field (return data, calldata, log data, bytecode) are shown before
+// truncation in detail views.
+const DefaultTruncateLength = 64
+
+// Config holds user preferences persisted across chop invocations.
+type Config struct {
+	TruncateLength int    `json:"truncateLength"`
+	Port           int    `json:"port"`
+	Hardfork       string `json:"hardfork"`
+	GasLimit       uint64 `json:"gasLimit"`
+	// ForkURL is the JSON-RPC endpoint chop forks state from, mirroring
+	// `chop serve --fork-url`. Empty disables forking.
+	ForkURL string `json:"forkURL"`
+	// ChainID is the chain ID chop reports and the default `chop serve
+	// --chain-id`, set by picking a chain from the TUI's chain
+	// selector (see internal/tui.ChainSelectorModel). 0 means unset.
+	ChainID uint64 `json:"chainID"`
+	// EnableCheats gates the Hardhat-style evm_* cheat methods (e.g.
+	// evm_setBalance) on `chop serve`. Defaults to true, since chop is
+	// a dev tool; set false to lock a shared/demo instance down.
+	EnableCheats bool `json:"enableCheats"`
+	// GasPrice is the constant wei-per-gas eth_gasPrice reports.
+	// Defaults to 1 gwei.
+	GasPrice uint64 `json:"gasPrice"`
+	// Verbose turns on request/response logging, mirroring `chop serve
+	// --log-level debug`. Hot-reloadable via SIGHUP.
+	Verbose bool `json:"verbose"`
+	// CorsOrigins lists the Access-Control-Allow-Origin values `chop
+	// serve` accepts, mirroring --cors-origin. Empty means allow every
+	// origin. Hot-reloadable via SIGHUP.
+	CorsOrigins []string `json:"corsOrigins"`
+	// LogFormat selects how the server renders log lines: "text" (the
+	// default, human-readable) or "json" (one JSON object per line, for
+	// log aggregators). Hot-reloadable via SIGHUP.
+	LogFormat string `json:"logFormat"`
+	// RateLimit caps requests per second per client IP, mirroring
+	// `chop serve --rate-limit`. 0 (the default) means unlimited.
+	RateLimit int `json:"rateLimit"`
+	// AuthToken, when set, requires every JSON-RPC request to carry
+	// "Authorization: Bearer <AuthToken>" (GET /health is exempt).
+	// Empty (the default) disables auth. Especially worth setting
+	// alongside EnableCheats, since evm_* cheat methods let any caller
+	// rewrite balances, code, and storage outright.
+	AuthToken string `json:"authToken"`
+	// Theme selects the TUI's color scheme by name (see KnownThemes):
+	// "dark" (the default), "light", or "high-contrast". An
+	// unrecognized name falls back to DefaultThemeName wherever colors
+	// are resolved via Config.Colors; Validate flags it as a problem.
+	Theme string `json:"theme"`
+}
+
+// Default returns the built-in defaults.
+func Default() Config {
+	return Config{
+		TruncateLength: DefaultTruncateLength,
+		Port:           8545,
+		Hardfork:       "cancun",
+		GasLimit:       1_000_000,
+		EnableCheats:   true,
+		GasPrice:       1_000_000_000,
+		LogFormat:      "text",
+		Theme:          DefaultThemeName,
+	}
+}
+
+// Source identifies where one of Config's effective field values came
+// from.
+type Source string
+
+const (
+	SourceDefault Source = "default"
+	SourceFile    Source = "file"
+	SourceEnv     Source = "env"
+	SourceFlag    Source = "flag"
+)
+
+// Sources maps a Config field's JSON name to where its effective
+// value came from, as populated by LoadWithSources.
+type Sources map[string]Source
+
+// defaultSources returns every field attributed to SourceDefault, the
+// starting point LoadWithSources narrows as it layers file and env
+// values on top.
+func defaultSources() Sources {
+	return Sources{
+		"truncateLength": SourceDefault,
+		"port":           SourceDefault,
+		"hardfork":       SourceDefault,
+		"gasLimit":       SourceDefault,
+		"forkURL":        SourceDefault,
+		"chainID":        SourceDefault,
+		"enableCheats":   SourceDefault,
+		"gasPrice":       SourceDefault,
+		"verbose":        SourceDefault,
+		"corsOrigins":    SourceDefault,
+		"logFormat":      SourceDefault,
+		"rateLimit":      SourceDefault,
+		"authToken":      SourceDefault,
+		"theme":          SourceDefault,
+	}
+}
+
+// Path returns the on-disk location of the config file. override, if
+// non-empty (typically from a --config flag), wins outright.
+// Otherwise $CHOP_CONFIG is honored, falling back to the OS user
+// config directory.
+func Path(override string) (string, error) {
+	if override != "" {
+		return override, nil
+	}
+	if p := os.Getenv("CHOP_CONFIG"); p != "" {
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "chop", "config.json"), nil
+}
+
+// fileConfig mirrors Config with pointer fields, so unmarshaling it
+// distinguishes "absent from the file" from "present with the zero
+// value" for source tracking.
+type fileConfig struct {
+	TruncateLength *int     `json:"truncateLength"`
+	Port           *int     `json:"port"`
+	Hardfork       *string  `json:"hardfork"`
+	GasLimit       *uint64  `json:"gasLimit"`
+	ForkURL        *string  `json:"forkURL"`
+	ChainID        *uint64  `json:"chainID"`
+	EnableCheats   *bool    `json:"enableCheats"`
+	GasPrice       *uint64  `json:"gasPrice"`
+	Verbose        *bool    `json:"verbose"`
+	CorsOrigins    []string `json:"corsOrigins"`
+	LogFormat      *string  `json:"logFormat"`
+	RateLimit      *int     `json:"rateLimit"`
+	AuthToken      *string  `json:"authToken"`
+	Theme          *string  `json:"theme"`
+}
+
+// Load reads the config file at the path resolved from configPath
+// (see Path), falling back to Default() for anything unset. configPath
+// is typically empty unless the caller has its own --config flag.
+func Load(configPath string) (Config, error) {
+	cfg, _, err := LoadWithSources(configPath)
+	return cfg, err
+}
+
+// LoadWithSources is Load plus a Sources report of which layer
+// (default, file, or env) won for each field. Precedence is file <
+// env; callers that also accept CLI flags (e.g. `chop config print
+// --port`) should overlay those on top and mark them SourceFlag
+// themselves.
+func LoadWithSources(configPath string) (Config, Sources, error) {
+	cfg := Default()
+	sources := defaultSources()
+
+	path, err := Path(configPath)
+	if err != nil {
+		return Config{}, nil, err
+	}
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		// No file: defaults (and env, below) stand as-is.
+	case err != nil:
+		return Config{}, nil, err
+	default:
+		var fc fileConfig
+		if err := json.Unmarshal(stripJSONComments(data), &fc); err != nil {
+			return Config{}, nil, fmt.Errorf("config: parsing %s: %w", path, err)
+		}
+		applyFileConfig(&cfg, sources, fc)
+	}
+
+	if err := applyEnv(&cfg, sources); err != nil {
+		return Config{}, nil, err
+	}
+
+	return cfg, sources, nil
+}
+
+func applyFileConfig(cfg *Config, sources Sources, fc fileConfig) {
+	if fc.TruncateLength != nil {
+		cfg.TruncateLength = *fc.TruncateLength
+		sources["truncateLength"] = SourceFile
+	}
+	if fc.Port != nil {
+		cfg.Port = *fc.Port
+		sources["port"] = SourceFile
+	}
+	if fc.Hardfork != nil {
+		cfg.Hardfork = *fc.Hardfork
+		sources["hardfork"] = SourceFile
+	}
+	if fc.GasLimit != nil {
+		cfg.GasLimit = *fc.GasLimit
+		sources["gasLimit"] = SourceFile
+	}
+	if fc.ForkURL != nil {
+		cfg.ForkURL = *fc.ForkURL
+		sources["forkURL"] = SourceFile
+	}
+	if fc.ChainID != nil {
+		cfg.ChainID = *fc.ChainID
+		sources["chainID"] = SourceFile
+	}
+	if fc.EnableCheats != nil {
+		cfg.EnableCheats = *fc.EnableCheats
+		sources["enableCheats"] = SourceFile
+	}
+	if fc.GasPrice != nil {
+		cfg.GasPrice = *fc.GasPrice
+		sources["gasPrice"] = SourceFile
+	}
+	if fc.Verbose != nil {
+		cfg.Verbose = *fc.Verbose
+		sources["verbose"] = SourceFile
+	}
+	if fc.CorsOrigins != nil {
+		// An empty list means the same as the nil default (allow every
+		// origin), so it leaves cfg.CorsOrigins nil.
+		if len(fc.CorsOrigins) > 0 {
+			cfg.CorsOrigins = fc.CorsOrigins
+		}
+		sources["corsOrigins"] = SourceFile
+	}
+	if fc.LogFormat != nil {
+		cfg.LogFormat = *fc.LogFormat
+		sources["logFormat"] = SourceFile
+	}
+	if fc.RateLimit != nil {
+		cfg.RateLimit = *fc.RateLimit
+		sources["rateLimit"] = SourceFile
+	}
+	if fc.AuthToken != nil {
+		cfg.AuthToken = *fc.AuthToken
+		sources["authToken"] = SourceFile
+	}
+	if fc.Theme != nil {
+		cfg.Theme = *fc.Theme
+		sources["theme"] = SourceFile
+	}
+}
+
+func applyEnv(cfg *Config, sources Sources) error {
+	if v := os.Getenv("CHOP_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_PORT: %w", err)
+		}
+		cfg.Port = port
+		sources["port"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_HARDFORK"); v != "" {
+		cfg.Hardfork = v
+		sources["hardfork"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_GAS_LIMIT"); v != "" {
+		gasLimit, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_GAS_LIMIT: %w", err)
+		}
+		cfg.GasLimit = gasLimit
+		sources["gasLimit"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_FORK_URL"); v != "" {
+		cfg.ForkURL = v
+		sources["forkURL"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_CHAIN_ID"); v != "" {
+		chainID, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_CHAIN_ID: %w", err)
+		}
+		cfg.ChainID = chainID
+		sources["chainID"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_ENABLE_CHEATS"); v != "" {
+		enableCheats, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_ENABLE_CHEATS: %w", err)
+		}
+		cfg.EnableCheats = enableCheats
+		sources["enableCheats"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_GAS_PRICE"); v != "" {
+		gasPrice, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_GAS_PRICE: %w", err)
+		}
+		cfg.GasPrice = gasPrice
+		sources["gasPrice"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_VERBOSE"); v != "" {
+		verbose, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_VERBOSE: %w", err)
+		}
+		cfg.Verbose = verbose
+		sources["verbose"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_CORS_ORIGINS"); v != "" {
+		cfg.CorsOrigins = strings.Split(v, ",")
+		sources["corsOrigins"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_LOG_FORMAT"); v != "" {
+		cfg.LogFormat = v
+		sources["logFormat"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_RATE_LIMIT"); v != "" {
+		rateLimit, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("config: CHOP_RATE_LIMIT: %w", err)
+		}
+		cfg.RateLimit = rateLimit
+		sources["rateLimit"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_AUTH_TOKEN"); v != "" {
+		cfg.AuthToken = v
+		sources["authToken"] = SourceEnv
+	}
+	if v := os.Getenv("CHOP_THEME"); v != "" {
+		cfg.Theme = v
+		sources["theme"] = SourceEnv
+	}
+	return nil
+}
+
+// stripJSONComments removes "// ..." line comments so Save's
+// human-edited, commented config files still parse as JSON. It is
+// line-oriented and does not understand "//" inside a string value;
+// chop's own config values never contain one.
+func stripJSONComments(data []byte) []byte {
+	var out bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if i := bytes.Index(line, []byte("//")); i >= 0 {
+			line = line[:i]
+		}
+		out.Write(line)
+		out.WriteByte('\n')
+	}
+	return out.Bytes()
+}
+
+// Save writes cfg to the config file, creating its directory if
+// needed.
+func Save(cfg Config) error {
+	path, err := Path("")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// defaultCommented is the template `chop config init` writes: the
+// built-in defaults, annotated with a "// ..." comment above each
+// field. stripJSONComments strips these back out on Load.
+const defaultCommented = `{
+  // Port chop's JSON-RPC server listens on.
+  "port": 8545,
+  // Hardfork chop executes calls under by default.
+  "hardfork": "cancun",
+  // GasLimit used for calls that don't specify their own.
+  "gasLimit": 1000000,
+  // ForkURL is the JSON-RPC endpoint to fork state from ("" disables forking).
+  "forkURL": "",
+  // ChainID is the default chain ID chop reports (0 means unset, falling back to --chain-id's own default).
+  "chainID": 0,
+  // EnableCheats gates the Hardhat-style evm_* cheat methods (e.g. evm_setBalance).
+  "enableCheats": true,
+  // GasPrice is the constant wei-per-gas eth_gasPrice reports (default 1 gwei).
+  "gasPrice": 1000000000,
+  // Verbose turns on request/response logging.
+  "verbose": false,
+  // CorsOrigins lists allowed Access-Control-Allow-Origin values (empty allows every origin).
+  "corsOrigins": [],
+  // LogFormat selects how the server renders log lines: "text" or "json".
+  "logFormat": "text",
+  // RateLimit caps requests per second per client IP (0 means unlimited).
+  "rateLimit": 0,
+  // AuthToken, when set, requires "Authorization: Bearer <token>" on every JSON-RPC request.
+  "authToken": "",
+  // TruncateLength is how many hex characters of long byte fields the TUI shows before truncating.
+  "truncateLength": 64,
+  // Theme selects the TUI's color scheme: "dark", "light", or "high-contrast".
+  "theme": "dark"
+}
+`
+
+// WriteDefault writes the commented default config to the path
+// resolved from configPath (see Path), creating its directory if
+// needed. It overwrites any existing file at that path.
+func WriteDefault(configPath string) (string, error) {
+	path, err := Path(configPath)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, []byte(defaultCommented), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
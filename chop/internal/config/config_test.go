@@ -0,0 +1,127 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadWithSourcesDefaultsWhenNoFileOrEnv(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", filepath.Join(t.TempDir(), "does-not-exist.json"))
+
+	cfg, sources, err := LoadWithSources("")
+	if err != nil {
+		t.Fatalf("LoadWithSources: %v", err)
+	}
+	if !reflect.DeepEqual(cfg, Default()) {
+		t.Errorf("got %+v, want defaults %+v", cfg, Default())
+	}
+	for field, source := range sources {
+		if source != SourceDefault {
+			t.Errorf("field %s: got source %s, want %s", field, source, SourceDefault)
+		}
+	}
+}
+
+func TestLoadWithSourcesFileOverridesDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"port": 9999, "hardfork": "shanghai"}`), 0o644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	cfg, sources, err := LoadWithSources(path)
+	if err != nil {
+		t.Fatalf("LoadWithSources: %v", err)
+	}
+	if cfg.Port != 9999 || cfg.Hardfork != "shanghai" {
+		t.Errorf("got port=%d hardfork=%s, want 9999/shanghai", cfg.Port, cfg.Hardfork)
+	}
+	if cfg.GasLimit != Default().GasLimit {
+		t.Errorf("got gasLimit=%d, want default %d", cfg.GasLimit, Default().GasLimit)
+	}
+	if sources["port"] != SourceFile || sources["hardfork"] != SourceFile {
+		t.Errorf("got sources %+v, want port/hardfork from file", sources)
+	}
+	if sources["gasLimit"] != SourceDefault {
+		t.Errorf("got gasLimit source %s, want default", sources["gasLimit"])
+	}
+}
+
+func TestLoadWithSourcesFileOverridesTheme(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"theme": "light"}`), 0o644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	cfg, sources, err := LoadWithSources(path)
+	if err != nil {
+		t.Fatalf("LoadWithSources: %v", err)
+	}
+	if cfg.Theme != "light" {
+		t.Errorf("got theme=%s, want light", cfg.Theme)
+	}
+	if sources["theme"] != SourceFile {
+		t.Errorf("got theme source %s, want file", sources["theme"])
+	}
+}
+
+func TestLoadWithSourcesEnvWinsOverFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"port": 9999}`), 0o644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+	t.Setenv("CHOP_PORT", "7777")
+
+	cfg, sources, err := LoadWithSources(path)
+	if err != nil {
+		t.Fatalf("LoadWithSources: %v", err)
+	}
+	if cfg.Port != 7777 {
+		t.Errorf("got port=%d, want 7777", cfg.Port)
+	}
+	if sources["port"] != SourceEnv {
+		t.Errorf("got port source %s, want env", sources["port"])
+	}
+}
+
+func TestLoadWithSourcesRejectsMalformedEnvInt(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", filepath.Join(t.TempDir(), "does-not-exist.json"))
+	t.Setenv("CHOP_PORT", "not-a-number")
+
+	if _, _, err := LoadWithSources(""); err == nil {
+		t.Error("want an error for a malformed CHOP_PORT")
+	}
+}
+
+func TestWriteDefaultThenLoadRoundtrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	written, err := WriteDefault(path)
+	if err != nil {
+		t.Fatalf("WriteDefault: %v", err)
+	}
+	if written != path {
+		t.Errorf("got path %s, want %s", written, path)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !reflect.DeepEqual(cfg, Default()) {
+		t.Errorf("got %+v, want defaults %+v", cfg, Default())
+	}
+}
+
+func TestPathOverrideWinsOverEnv(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", "/should-not-be-used.json")
+
+	path, err := Path("/explicit/path.json")
+	if err != nil {
+		t.Fatalf("Path: %v", err)
+	}
+	if path != "/explicit/path.json" {
+		t.Errorf("got %s, want /explicit/path.json", path)
+	}
+}
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Action identifies one thing a keypress can do in the TUI, independent
+// of which key triggers it.
+type Action string
+
+const (
+	ActionUp      Action = "up"
+	ActionDown    Action = "down"
+	ActionSelect  Action = "select"
+	ActionExecute Action = "execute"
+	ActionCancel  Action = "cancel"
+	ActionUndo    Action = "undo"
+	ActionRedo    Action = "redo"
+)
+
+// Keymap binds each Action to the keys (as reported by
+// tea.KeyMsg.String()) that trigger it. An action can have more than
+// one binding, e.g. arrow keys alongside vim-style hjkl.
+//
+// Keymap lives in its own file (keymapPath, a sibling of the config
+// file) rather than as a field on Config, like Labels.
+type Keymap map[Action][]string
+
+// DefaultKeymap is chop's built-in key bindings, matching what the TUI
+// widgets already hard-code (see internal/tui).
+func DefaultKeymap() Keymap {
+	return Keymap{
+		ActionUp:      {"up"},
+		ActionDown:    {"down"},
+		ActionSelect:  {"enter"},
+		ActionExecute: {"enter"},
+		ActionCancel:  {"esc"},
+		ActionUndo:    {"ctrl+z"},
+		ActionRedo:    {"ctrl+y"},
+	}
+}
+
+// IsKey reports whether key is bound to action in km.
+func IsKey(km Keymap, action Action, key string) bool {
+	for _, bound := range km[action] {
+		if bound == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Conflicts reports every key that's bound to more than one Action in
+// km, as human-readable problem strings, so a bad remap can be
+// surfaced before it confuses the user at runtime (e.g. rebinding
+// ActionUndo to "enter" would silently swallow ActionSelect/Execute).
+func (km Keymap) Conflicts() []string {
+	boundBy := make(map[string][]Action)
+	for action, keys := range km {
+		for _, key := range keys {
+			boundBy[key] = append(boundBy[key], action)
+		}
+	}
+
+	var keys []string
+	for key, actions := range boundBy {
+		if len(actions) > 1 && !isSelectExecutePair(actions) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	problems := make([]string, 0, len(keys))
+	for _, key := range keys {
+		actions := boundBy[key]
+		sort.Slice(actions, func(i, j int) bool { return actions[i] < actions[j] })
+		problems = append(problems, fmt.Sprintf("key %q is bound to more than one action: %v", key, actions))
+	}
+	return problems
+}
+
+// isSelectExecutePair reports whether actions is exactly ActionSelect
+// and ActionExecute, which share "enter" in DefaultKeymap and so are
+// not reported as a conflict with each other.
+func isSelectExecutePair(actions []Action) bool {
+	if len(actions) != 2 {
+		return false
+	}
+	return (actions[0] == ActionSelect && actions[1] == ActionExecute) ||
+		(actions[0] == ActionExecute && actions[1] == ActionSelect)
+}
+
+// keymapPath returns the on-disk location of the keymap file, alongside
+// the config file resolved from the same override/env/OS precedence as
+// Path.
+func keymapPath(override string) (string, error) {
+	configPath, err := Path(override)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "keymap.json"), nil
+}
+
+// LoadKeymap reads the keymap file at the path resolved from
+// configPath (see Path), layering its bindings on top of
+// DefaultKeymap: an action named in the file replaces its default
+// binding outright, and an action the file doesn't mention keeps its
+// default. A missing file yields DefaultKeymap unchanged.
+func LoadKeymap(configPath string) (Keymap, error) {
+	km := DefaultKeymap()
+
+	path, err := keymapPath(configPath)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return km, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var overrides map[Action][]string
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+	for action, keys := range overrides {
+		km[action] = keys
+	}
+	return km, nil
+}
+
+// SaveKeymap writes km as the keymap file, creating its directory if
+// needed, so a remap made via the TUI or `chop config` persists across
+// restarts.
+func SaveKeymap(configPath string, km Keymap) error {
+	path, err := keymapPath(configPath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(km, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsKeyMatchesDefaultBinding(t *testing.T) {
+	km := DefaultKeymap()
+	if !IsKey(km, ActionExecute, "enter") {
+		t.Error("want the default ActionExecute binding to match \"enter\"")
+	}
+	if IsKey(km, ActionExecute, "ctrl+enter") {
+		t.Error("want an unbound key to not match")
+	}
+}
+
+func TestRemappedKeyExecuteIsRecognizedInsteadOfDefault(t *testing.T) {
+	km := DefaultKeymap()
+	km[ActionExecute] = []string{"ctrl+enter"}
+
+	if !IsKey(km, ActionExecute, "ctrl+enter") {
+		t.Error("want the remapped key to match")
+	}
+	if IsKey(km, ActionExecute, "enter") {
+		t.Error("want the default key to no longer match after remapping")
+	}
+}
+
+func TestKeymapConflictsDetectsSharedBinding(t *testing.T) {
+	km := DefaultKeymap()
+	km[ActionUndo] = []string{"enter"}
+
+	problems := km.Conflicts()
+	if len(problems) != 1 {
+		t.Fatalf("got %d conflicts, want 1: %v", len(problems), problems)
+	}
+}
+
+func TestKeymapConflictsIsEmptyForDefaults(t *testing.T) {
+	if problems := DefaultKeymap().Conflicts(); len(problems) != 0 {
+		t.Errorf("got conflicts %v, want none for the default bindings", problems)
+	}
+}
+
+func TestLoadKeymapDefaultsWhenNoFile(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", filepath.Join(t.TempDir(), "does-not-exist.json"))
+
+	km, err := LoadKeymap("")
+	if err != nil {
+		t.Fatalf("LoadKeymap: %v", err)
+	}
+	if !IsKey(km, ActionExecute, "enter") {
+		t.Error("want the default binding when no keymap file exists")
+	}
+}
+
+func TestLoadKeymapOverridesReplaceOnlyNamedActions(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	path, err := keymapPath(configPath)
+	if err != nil {
+		t.Fatalf("keymapPath: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"execute": ["ctrl+enter"]}`), 0o644); err != nil {
+		t.Fatalf("writing keymap: %v", err)
+	}
+
+	km, err := LoadKeymap(configPath)
+	if err != nil {
+		t.Fatalf("LoadKeymap: %v", err)
+	}
+	if !IsKey(km, ActionExecute, "ctrl+enter") || IsKey(km, ActionExecute, "enter") {
+		t.Error("want ActionExecute remapped to ctrl+enter only")
+	}
+	if !IsKey(km, ActionUp, "up") {
+		t.Error("want ActionUp to keep its default binding")
+	}
+}
+
+func TestSaveKeymapThenLoadRoundtrips(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	km := DefaultKeymap()
+	km[ActionDown] = []string{"j"}
+
+	if err := SaveKeymap(configPath, km); err != nil {
+		t.Fatalf("SaveKeymap: %v", err)
+	}
+
+	loaded, err := LoadKeymap(configPath)
+	if err != nil {
+		t.Fatalf("LoadKeymap: %v", err)
+	}
+	if !IsKey(loaded, ActionDown, "j") {
+		t.Error("want the saved remap to round-trip")
+	}
+}
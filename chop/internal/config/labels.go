@@ -0,0 +1,118 @@
+package config
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// Labels maps a short name (e.g. "alice") to the 0x-prefixed address
+// it stands for, so the TUI and CLI can accept a name anywhere an
+// address is expected.
+//
+// Labels live in their own file (labelsPath, a sibling of the config
+// file) rather than as a field on Config.
+type Labels map[string]string
+
+// labelsPath returns the on-disk location of the labels file,
+// alongside the config file resolved from the same override/env/OS
+// precedence as Path.
+func labelsPath(override string) (string, error) {
+	configPath, err := Path(override)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configPath), "labels.json"), nil
+}
+
+// LoadLabels reads the labels file at the path resolved from
+// configPath (see Path), returning an empty Labels if it doesn't
+// exist yet.
+func LoadLabels(configPath string) (Labels, error) {
+	path, err := labelsPath(configPath)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return Labels{}, nil
+	case err != nil:
+		return nil, err
+	}
+	var labels Labels
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+	return labels, nil
+}
+
+// SaveLabel records name as a label for address, persisting it
+// alongside chop's existing labels. address must be a 42-character
+// 0x-prefixed hex address.
+func SaveLabel(name, address string) error {
+	if !isAddressHex(address) {
+		return fmt.Errorf("config: %q is not a 42-character 0x-prefixed address", address)
+	}
+	labels, err := LoadLabels("")
+	if err != nil {
+		return err
+	}
+	labels[name] = address
+
+	path, err := labelsPath("")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(labels, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// InspectAddress resolves input to an address for the state inspector:
+// a well-formed 42-character 0x-prefixed hex address is decoded
+// directly, otherwise input is looked up in labels (populated by
+// SaveLabel). An unrecognized non-hex input is an error rather than a
+// zero address, so a typo in a label name fails loudly.
+func InspectAddress(labels Labels, input string) (types.Address, error) {
+	if isAddressHex(input) {
+		return parseAddressHex(input)
+	}
+	address, ok := labels[input]
+	if !ok {
+		return types.Address{}, fmt.Errorf("config: %q is neither a valid address nor a known label", input)
+	}
+	return parseAddressHex(address)
+}
+
+// isAddressHex reports whether s is a well-formed 42-character
+// 0x-prefixed address.
+func isAddressHex(s string) bool {
+	if !strings.HasPrefix(s, "0x") || len(s) != 42 {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
+
+// parseAddressHex decodes a 0x-prefixed hex address already validated
+// by isAddressHex.
+func parseAddressHex(s string) (types.Address, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return types.Address{}, fmt.Errorf("config: invalid address hex: %w", err)
+	}
+	var addr types.Address
+	copy(addr[:], raw)
+	return addr, nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestInspectAddressResolvesKnownLabel(t *testing.T) {
+	labels := Labels{"alice": "0x00000000000000000000000000000000000000aa"}
+
+	addr, err := InspectAddress(labels, "alice")
+	if err != nil {
+		t.Fatalf("InspectAddress: %v", err)
+	}
+	if addr[19] != 0xaa {
+		t.Errorf("got %x, want an address ending in 0xaa", addr)
+	}
+}
+
+func TestInspectAddressRejectsUnknownNonHexInput(t *testing.T) {
+	labels := Labels{"alice": "0x00000000000000000000000000000000000000aa"}
+
+	if _, err := InspectAddress(labels, "bob"); err == nil {
+		t.Error("expected an error for an unknown label")
+	}
+}
+
+func TestInspectAddressAcceptsRawHexWithoutConsultingLabels(t *testing.T) {
+	labels := Labels{}
+
+	addr, err := InspectAddress(labels, "0x00000000000000000000000000000000000000bb")
+	if err != nil {
+		t.Fatalf("InspectAddress: %v", err)
+	}
+	if addr[19] != 0xbb {
+		t.Errorf("got %x, want an address ending in 0xbb", addr)
+	}
+}
+
+func TestSaveLabelThenInspectAddressRoundtrips(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+
+	if err := SaveLabel("alice", "0x00000000000000000000000000000000000000cc"); err != nil {
+		t.Fatalf("SaveLabel: %v", err)
+	}
+
+	labels, err := LoadLabels("")
+	if err != nil {
+		t.Fatalf("LoadLabels: %v", err)
+	}
+	addr, err := InspectAddress(labels, "alice")
+	if err != nil {
+		t.Fatalf("InspectAddress: %v", err)
+	}
+	if addr[19] != 0xcc {
+		t.Errorf("got %x, want an address ending in 0xcc", addr)
+	}
+}
+
+func TestSaveLabelRejectsMalformedAddress(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+
+	if err := SaveLabel("alice", "not-an-address"); err == nil {
+		t.Error("expected an error for a malformed address")
+	}
+}
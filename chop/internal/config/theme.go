@@ -0,0 +1,87 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// DefaultThemeName is the preset used when Config.Theme is empty or
+// unrecognized.
+const DefaultThemeName = "dark"
+
+// Theme maps the TUI's semantic color roles to "#RRGGBB" hex values.
+// Palette is small on purpose: Primary for normal UI chrome, Warning
+// for things like high gas usage or a stale fork cache, and Error for
+// failed calls and validation problems.
+type Theme struct {
+	Primary string
+	Warning string
+	Error   string
+}
+
+// themePresets are chop's built-in named color schemes, selected via
+// Config.Theme. Keys are lowercase to match the "theme" config field
+// and the CHOP_THEME env var as written.
+var themePresets = map[string]Theme{
+	"dark": {
+		Primary: "#00ADD8",
+		Warning: "#FFB000",
+		Error:   "#FF5555",
+	},
+	"light": {
+		Primary: "#0B5FFF",
+		Warning: "#B36B00",
+		Error:   "#C4262E",
+	},
+	"high-contrast": {
+		Primary: "#00FFFF",
+		Warning: "#FFFF00",
+		Error:   "#FF0000",
+	},
+}
+
+// KnownThemes lists the names Config.Theme accepts, sorted for stable
+// output in error messages and `chop config` listings.
+func KnownThemes() []string {
+	names := make([]string, 0, len(themePresets))
+	for name := range themePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveTheme looks up the built-in preset named name. An empty name
+// resolves to DefaultThemeName; any other unrecognized name is an
+// error listing the known presets.
+func ResolveTheme(name string) (Theme, error) {
+	if name == "" {
+		name = DefaultThemeName
+	}
+	theme, ok := themePresets[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("config: theme %q is not one of the known presets %v", name, KnownThemes())
+	}
+	return theme, nil
+}
+
+// Colors resolves cfg.Theme to its Theme, falling back to
+// DefaultThemeName if cfg.Theme is empty or unrecognized. Callers that
+// need to surface an unknown theme name as an error should use
+// Validate instead; Colors is for renderers that just need a usable
+// palette.
+func (cfg Config) Colors() Theme {
+	if theme, err := ResolveTheme(cfg.Theme); err == nil {
+		return theme
+	}
+	theme, _ := ResolveTheme(DefaultThemeName)
+	return theme
+}
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// isValidHexColor reports whether s is a well-formed "#RRGGBB" color.
+func isValidHexColor(s string) bool {
+	return hexColorPattern.MatchString(s)
+}
@@ -0,0 +1,75 @@
+package config
+
+import "testing"
+
+func TestResolveThemeDefaultsWhenNameIsEmpty(t *testing.T) {
+	theme, err := ResolveTheme("")
+	if err != nil {
+		t.Fatalf("ResolveTheme: %v", err)
+	}
+	want, _ := ResolveTheme(DefaultThemeName)
+	if theme != want {
+		t.Errorf("got %+v, want default theme %+v", theme, want)
+	}
+}
+
+func TestResolveThemeRejectsUnknownName(t *testing.T) {
+	if _, err := ResolveTheme("neon"); err == nil {
+		t.Error("want an error for an unknown theme name")
+	}
+}
+
+func TestConfigColorsChangesWithTheme(t *testing.T) {
+	cfg := Default()
+	darkPrimary := cfg.Colors().Primary
+
+	cfg.Theme = "light"
+	lightPrimary := cfg.Colors().Primary
+
+	if darkPrimary == lightPrimary {
+		t.Errorf("got the same Primary color %q for both dark and light themes", darkPrimary)
+	}
+}
+
+func TestConfigColorsFallsBackToDefaultForUnknownTheme(t *testing.T) {
+	cfg := Default()
+	cfg.Theme = "neon"
+
+	want, _ := ResolveTheme(DefaultThemeName)
+	if got := cfg.Colors(); got != want {
+		t.Errorf("got %+v, want default theme %+v", got, want)
+	}
+}
+
+func TestKnownThemesAreAllValidHexColors(t *testing.T) {
+	for _, name := range KnownThemes() {
+		theme, err := ResolveTheme(name)
+		if err != nil {
+			t.Fatalf("ResolveTheme(%q): %v", name, err)
+		}
+		for field, hex := range map[string]string{
+			"Primary": theme.Primary,
+			"Warning": theme.Warning,
+			"Error":   theme.Error,
+		} {
+			if !isValidHexColor(hex) {
+				t.Errorf("theme %q: %s = %q is not a valid #RRGGBB hex color", name, field, hex)
+			}
+		}
+	}
+}
+
+func TestIsValidHexColor(t *testing.T) {
+	cases := map[string]bool{
+		"#00ADD8": true,
+		"#fff":    false,
+		"00ADD8":  false,
+		"#GGGGGG": false,
+		"":        false,
+	}
+	for input, want := range cases {
+		if got := isValidHexColor(input); got != want {
+			t.Errorf("isValidHexColor(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+// ValidationError reports every problem Validate found, rather than
+// just the first.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("config: %d problem(s): %s", len(e.Problems), strings.Join(e.Problems, "; "))
+}
+
+// Validate checks cfg for values that would fail later, once chop
+// actually tries to use them: an out-of-range Port, an unrecognized
+// Hardfork, a zero GasLimit, a malformed ForkURL, or an unrecognized
+// Theme. It returns a *ValidationError listing every problem found, or
+// nil if cfg is usable.
+func Validate(cfg Config) error {
+	var problems []string
+
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		problems = append(problems, fmt.Sprintf("port %d is out of range 1-65535", cfg.Port))
+	}
+
+	if !isKnownHardfork(cfg.Hardfork) {
+		problems = append(problems, fmt.Sprintf("hardfork %q is not one of the known forks", cfg.Hardfork))
+	}
+
+	if cfg.GasLimit == 0 {
+		problems = append(problems, "gasLimit must be non-zero")
+	}
+
+	if cfg.ForkURL != "" {
+		if u, err := url.Parse(cfg.ForkURL); err != nil || u.Scheme == "" || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("forkURL %q is not a valid URL", cfg.ForkURL))
+		}
+	}
+
+	if _, err := ResolveTheme(cfg.Theme); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: problems}
+}
+
+func isKnownHardfork(name string) bool {
+	for _, f := range bytecode.KnownForks() {
+		if string(f) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateAcceptsDefaults(t *testing.T) {
+	if err := Validate(Default()); err != nil {
+		t.Errorf("want no error for defaults, got %v", err)
+	}
+}
+
+func TestValidateRejectsOutOfRangePortAndUnknownHardfork(t *testing.T) {
+	cfg := Default()
+	cfg.Port = 70000
+	cfg.Hardfork = "bogusfork"
+
+	err := Validate(cfg)
+	if err == nil {
+		t.Fatal("want a validation error")
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("got %T, want *ValidationError", err)
+	}
+	if len(verr.Problems) != 2 {
+		t.Fatalf("got %d problems, want 2: %v", len(verr.Problems), verr.Problems)
+	}
+	if !strings.Contains(err.Error(), "port") || !strings.Contains(err.Error(), "hardfork") {
+		t.Errorf("got %q, want it to mention both problems", err.Error())
+	}
+}
+
+func TestValidateRejectsZeroGasLimit(t *testing.T) {
+	cfg := Default()
+	cfg.GasLimit = 0
+
+	if err := Validate(cfg); err == nil {
+		t.Error("want an error for a zero gasLimit")
+	}
+}
+
+func TestValidateRejectsMalformedForkURL(t *testing.T) {
+	cfg := Default()
+	cfg.ForkURL = "not a url"
+
+	if err := Validate(cfg); err == nil {
+		t.Error("want an error for a malformed forkURL")
+	}
+}
+
+func TestValidateAcceptsValidForkURL(t *testing.T) {
+	cfg := Default()
+	cfg.ForkURL = "https://eth-mainnet.example.com"
+
+	if err := Validate(cfg); err != nil {
+		t.Errorf("want no error, got %v", err)
+	}
+}
+
+func TestValidateRejectsUnknownTheme(t *testing.T) {
+	cfg := Default()
+	cfg.Theme = "neon"
+
+	err := Validate(cfg)
+	if err == nil {
+		t.Fatal("want an error for an unknown theme")
+	}
+	if !strings.Contains(err.Error(), "theme") {
+		t.Errorf("got %q, want it to mention the theme problem", err.Error())
+	}
+}
@@ -0,0 +1,72 @@
+// Package contracts tracks per-address call activity for the "hot
+// contracts" analytics surfaced in the TUI and over RPC.
+package contracts
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// Stat tallies activity for a single contract address across the
+// session.
+type Stat struct {
+	Address types.Address `json:"address"`
+	Calls   uint64        `json:"calls"`
+	GasUsed uint64        `json:"gasUsed"`
+}
+
+// Tracker keys running call/gas totals by target address.
+type Tracker struct {
+	mu    sync.RWMutex
+	stats map[types.Address]*Stat
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{stats: make(map[types.Address]*Stat)}
+}
+
+// Record folds a single executed call into the tally. Calls with no
+// target (contract creation) are ignored since there is no deployed
+// address to attribute activity to.
+func (t *Tracker) Record(call types.CallResult) {
+	if call.To == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.stats[*call.To]
+	if !ok {
+		s = &Stat{Address: *call.To}
+		t.stats[*call.To] = s
+	}
+	s.Calls++
+	s.GasUsed += call.GasUsed
+}
+
+// Reset clears all tallies. Called whenever the chain/session resets.
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats = make(map[types.Address]*Stat)
+}
+
+// Sorted returns every tracked contract's stats ordered by descending
+// call count, then descending gas used.
+func (t *Tracker) Sorted() []Stat {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	out := make([]Stat, 0, len(t.stats))
+	for _, s := range t.stats {
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Calls != out[j].Calls {
+			return out[i].Calls > out[j].Calls
+		}
+		return out[i].GasUsed > out[j].GasUsed
+	})
+	return out
+}
@@ -0,0 +1,51 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func addr(b byte) types.Address {
+	var a types.Address
+	a[19] = b
+	return a
+}
+
+func TestTrackerRecordTalliesCallsAndGas(t *testing.T) {
+	tr := NewTracker()
+	a, b := addr(1), addr(2)
+
+	tr.Record(types.CallResult{To: &a, GasUsed: 100})
+	tr.Record(types.CallResult{To: &a, GasUsed: 50})
+	tr.Record(types.CallResult{To: &b, GasUsed: 10})
+
+	stats := tr.Sorted()
+	if len(stats) != 2 {
+		t.Fatalf("want 2 contracts, got %d", len(stats))
+	}
+	if stats[0].Address != a || stats[0].Calls != 2 || stats[0].GasUsed != 150 {
+		t.Errorf("unexpected stats for hottest contract: %+v", stats[0])
+	}
+	if stats[1].Address != b || stats[1].Calls != 1 || stats[1].GasUsed != 10 {
+		t.Errorf("unexpected stats for second contract: %+v", stats[1])
+	}
+}
+
+func TestTrackerIgnoresContractCreation(t *testing.T) {
+	tr := NewTracker()
+	tr.Record(types.CallResult{To: nil, GasUsed: 100})
+	if len(tr.Sorted()) != 0 {
+		t.Errorf("contract creation should not be tallied")
+	}
+}
+
+func TestTrackerReset(t *testing.T) {
+	tr := NewTracker()
+	a := addr(1)
+	tr.Record(types.CallResult{To: &a, GasUsed: 5})
+	tr.Reset()
+	if len(tr.Sorted()) != 0 {
+		t.Errorf("want empty tracker after reset")
+	}
+}
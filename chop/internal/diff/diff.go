@@ -0,0 +1,137 @@
+// Package diff compares chop's guillotine-backed EVM execution
+// against an external reference implementation (revme or geth) for
+// the same bytecode and calldata, to catch execution divergences.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+)
+
+// Reference names a reference EVM implementation to diff chop's
+// execution against.
+type Reference string
+
+const (
+	ReferenceRevme Reference = "revme"
+	ReferenceGeth  Reference = "geth"
+)
+
+// Options configures a single diff comparison.
+type Options struct {
+	Bytecode []byte
+	Calldata []byte
+	GasLimit uint64
+	Fork     bytecode.Fork
+
+	// Reference selects which implementation to compare against.
+	Reference Reference
+	// ReferenceBinary is the path to the reference implementation's
+	// binary. Empty uses the implementation's default name ("revme"
+	// or "evm") resolved against PATH.
+	ReferenceBinary string
+
+	// OutputPath, if set, is where RunCategory writes its JSON report.
+	// Ignored unless Format is "json".
+	OutputPath string
+	// Format selects RunCategory's report format: "json" writes the
+	// machine-readable summary described by OutputPath; anything else
+	// (the default) prints a human-readable PASS/FAIL line per fixture
+	// to stdout, in fixture order, once every fixture has run.
+	Format string
+
+	// Concurrency caps how many fixtures RunFixtures/RunCategory diff
+	// at once. Zero or negative defaults to runtime.GOMAXPROCS(0).
+	// Ignored by Run, which only ever diffs one fixture.
+	Concurrency int
+}
+
+// Result is the outcome of comparing chop's execution against the
+// reference implementation for one fixture.
+type Result struct {
+	Matched    bool
+	ChopGas    uint64
+	RefGas     uint64
+	ChopOutput []byte
+	RefOutput  []byte
+	// Mismatch describes the first divergence found, empty if Matched.
+	Mismatch string
+}
+
+// referenceResult is what every referenceRunner reports, regardless
+// of which external implementation produced it.
+type referenceResult struct {
+	GasUsed uint64
+	Output  []byte
+}
+
+// referenceRunner executes bytecode/calldata against an external
+// reference EVM. revme and geth each get their own implementation;
+// tests substitute a stub satisfying this interface directly.
+type referenceRunner interface {
+	Run(opts Options) (referenceResult, error)
+}
+
+func newReferenceRunner(opts Options) (referenceRunner, error) {
+	switch opts.Reference {
+	case ReferenceRevme:
+		return &revmeRunner{binary: opts.ReferenceBinary}, nil
+	case ReferenceGeth:
+		return &gethRunner{binary: opts.ReferenceBinary}, nil
+	default:
+		return nil, fmt.Errorf("diff: unknown reference implementation %q", opts.Reference)
+	}
+}
+
+// Run executes opts.Bytecode/Calldata against both executor (chop's
+// EVM) and the configured reference implementation, and reports
+// whether their gas usage and output agree.
+func Run(executor evm.Executor, opts Options) (Result, error) {
+	runner, err := newReferenceRunner(opts)
+	if err != nil {
+		return Result{}, err
+	}
+	return compare(executor, runner, opts)
+}
+
+// compare holds Run's logic with the reference runner passed in
+// directly, so tests can substitute a stub instead of shelling out to
+// a real revme/geth binary.
+func compare(executor evm.Executor, runner referenceRunner, opts Options) (Result, error) {
+	chopRes, err := executor.ExecuteCall(evm.ExecutionContext{
+		Code:     opts.Bytecode,
+		Input:    opts.Calldata,
+		GasLimit: opts.GasLimit,
+		Fork:     opts.Fork,
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("diff: chop execution: %w", err)
+	}
+
+	ref, err := runner.Run(opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{ChopGas: chopRes.GasUsed, RefGas: ref.GasUsed, ChopOutput: chopRes.Output, RefOutput: ref.Output}
+	switch {
+	case chopRes.GasUsed != ref.GasUsed:
+		result.Mismatch = fmt.Sprintf("gas mismatch: chop=%d %s=%d", chopRes.GasUsed, opts.Reference, ref.GasUsed)
+	case !bytes.Equal(chopRes.Output, ref.Output):
+		result.Mismatch = fmt.Sprintf("output mismatch: chop=0x%x %s=0x%x", chopRes.Output, opts.Reference, ref.Output)
+	default:
+		result.Matched = true
+	}
+	return result, nil
+}
+
+// lastJSONLine returns the last non-empty line of out, which for both
+// revme's and geth's --json output is the trailing summary object
+// (the lines before it are the per-opcode trace).
+func lastJSONLine(out []byte) []byte {
+	lines := bytes.Split(bytes.TrimSpace(out), []byte("\n"))
+	return lines[len(lines)-1]
+}
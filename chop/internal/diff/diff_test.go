@@ -0,0 +1,91 @@
+package diff
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// fakeExecutor is a stub evm.Executor returning a fixed result, so
+// tests can drive diff.compare without shelling out to guillotine.
+type fakeExecutor struct {
+	result types.CallResult
+	err    error
+}
+
+func (f *fakeExecutor) ExecuteCall(ctx evm.ExecutionContext) (types.CallResult, error) {
+	return f.result, f.err
+}
+
+// stubRunner is a stub referenceRunner, so tests can drive
+// diff.compare without shelling out to a real revme/geth binary.
+type stubRunner struct {
+	result referenceResult
+	err    error
+}
+
+func (s *stubRunner) Run(opts Options) (referenceResult, error) {
+	return s.result, s.err
+}
+
+var errStub = fmt.Errorf("stub reference runner failure")
+
+func TestCompareMatchedWhenGasAndOutputAgree(t *testing.T) {
+	executor := &fakeExecutor{result: types.CallResult{GasUsed: 21_000, Output: []byte{0x01}}}
+	runner := &stubRunner{result: referenceResult{GasUsed: 21_000, Output: []byte{0x01}}}
+
+	result, err := compare(executor, runner, Options{Reference: ReferenceRevme})
+	if err != nil {
+		t.Fatalf("compare: %v", err)
+	}
+	if !result.Matched || result.Mismatch != "" {
+		t.Errorf("got %+v, want Matched with no Mismatch", result)
+	}
+}
+
+func TestCompareReportsGasMismatch(t *testing.T) {
+	executor := &fakeExecutor{result: types.CallResult{GasUsed: 21_000, Output: []byte{0x01}}}
+	runner := &stubRunner{result: referenceResult{GasUsed: 21_064, Output: []byte{0x01}}}
+
+	result, err := compare(executor, runner, Options{Reference: ReferenceGeth})
+	if err != nil {
+		t.Fatalf("compare: %v", err)
+	}
+	if result.Matched {
+		t.Fatal("want Matched false on a gas mismatch")
+	}
+	if result.Mismatch == "" {
+		t.Error("want a non-empty Mismatch description")
+	}
+}
+
+func TestCompareReportsOutputMismatch(t *testing.T) {
+	executor := &fakeExecutor{result: types.CallResult{GasUsed: 21_000, Output: []byte{0x01}}}
+	runner := &stubRunner{result: referenceResult{GasUsed: 21_000, Output: []byte{0x02}}}
+
+	result, err := compare(executor, runner, Options{Reference: ReferenceRevme})
+	if err != nil {
+		t.Fatalf("compare: %v", err)
+	}
+	if result.Matched {
+		t.Fatal("want Matched false on an output mismatch")
+	}
+}
+
+func TestCompareReturnsReferenceRunnerError(t *testing.T) {
+	executor := &fakeExecutor{result: types.CallResult{GasUsed: 21_000}}
+	runner := &stubRunner{err: errStub}
+
+	if _, err := compare(executor, runner, Options{Reference: ReferenceGeth}); err == nil {
+		t.Error("want the stub runner's error propagated")
+	}
+}
+
+func TestRunRejectsUnknownReference(t *testing.T) {
+	executor := &fakeExecutor{}
+	if _, err := Run(executor, Options{Reference: "unknown"}); err == nil {
+		t.Error("want an error for an unrecognized reference implementation")
+	}
+}
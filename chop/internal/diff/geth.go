@@ -0,0 +1,66 @@
+package diff
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// gethRunner executes bytecode/calldata against go-ethereum's `evm`
+// tool, parsing its --json output for the final gas usage and return
+// data.
+type gethRunner struct {
+	binary string // defaults to "evm" on PATH if empty
+}
+
+func (g *gethRunner) binaryPath() string {
+	if g.binary != "" {
+		return g.binary
+	}
+	return "evm"
+}
+
+func (g *gethRunner) Run(opts Options) (referenceResult, error) {
+	path, err := exec.LookPath(g.binaryPath())
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: geth's evm binary not found (looked for %q) - install go-ethereum or pass --reference-binary: %w", g.binaryPath(), err)
+	}
+
+	cmd := exec.Command(path, "run",
+		"--code", hex.EncodeToString(opts.Bytecode),
+		"--input", hex.EncodeToString(opts.Calldata),
+		"--gas", fmt.Sprintf("%d", opts.GasLimit),
+		"--json",
+	)
+	out, err := cmd.Output()
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: running geth evm: %w", err)
+	}
+	return parseGethOutput(out)
+}
+
+// gethSummary is the trailing summary object geth's evm tool prints
+// after the per-opcode --json trace lines.
+type gethSummary struct {
+	Output  string `json:"output"`
+	GasUsed string `json:"gasUsed"` // hex-encoded, e.g. "0x5208"
+}
+
+func parseGethOutput(out []byte) (referenceResult, error) {
+	var summary gethSummary
+	if err := json.Unmarshal(lastJSONLine(out), &summary); err != nil {
+		return referenceResult{}, fmt.Errorf("diff: parsing geth evm output: %w", err)
+	}
+	gasUsed, err := strconv.ParseUint(strings.TrimPrefix(summary.GasUsed, "0x"), 16, 64)
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: parsing geth gasUsed %q: %w", summary.GasUsed, err)
+	}
+	output, err := hex.DecodeString(strings.TrimPrefix(summary.Output, "0x"))
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: decoding geth output: %w", err)
+	}
+	return referenceResult{GasUsed: gasUsed, Output: output}, nil
+}
@@ -0,0 +1,42 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGethOutputReadsTrailingSummaryLine(t *testing.T) {
+	out := []byte(`{"pc":0,"op":96,"gas":"0x5208"}
+{"pc":2,"op":0,"gas":"0x5205"}
+{"output":"0x0a","gasUsed":"0x5208","time":123}
+`)
+	result, err := parseGethOutput(out)
+	if err != nil {
+		t.Fatalf("parseGethOutput: %v", err)
+	}
+	if result.GasUsed != 0x5208 {
+		t.Errorf("got gas %d, want %d", result.GasUsed, 0x5208)
+	}
+	if string(result.Output) != "\x0a" {
+		t.Errorf("got output %x, want 0a", result.Output)
+	}
+}
+
+func TestParseGethOutputRejectsMalformedJSON(t *testing.T) {
+	if _, err := parseGethOutput([]byte("not json")); err == nil {
+		t.Error("want an error for malformed geth output")
+	}
+}
+
+func TestGethRunnerMissingBinaryReturnsHelpfulError(t *testing.T) {
+	runner := &gethRunner{binary: "chop-diff-test-no-such-geth-binary"}
+	_, err := runner.Run(Options{})
+	if err == nil {
+		t.Fatal("want an error when the geth binary isn't found")
+	}
+	got := err.Error()
+	if !strings.Contains(got, "binary not found") || !strings.Contains(got, "chop-diff-test-no-such-geth-binary") {
+		t.Errorf("got error %q, want it to name the missing binary", got)
+	}
+}
+
@@ -0,0 +1,191 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fixtures"
+)
+
+// FixtureReport is one fixture's entry in a diff JSON report. Mismatch
+// describes the first field that diverged (a "gas mismatch: ..." or
+// "output mismatch: ..." line), or holds the error that stopped the
+// comparison — chop only
+// compares the reference implementation's final gas/output summary,
+// not its per-opcode trace, so a divergence is never more granular
+// than that.
+type FixtureReport struct {
+	Name     string `json:"name"`
+	Fork     string `json:"fork"`
+	Matched  bool   `json:"matched"`
+	Mismatch string `json:"mismatch,omitempty"`
+}
+
+// RunFixtures diffs every fixture in fxs against the reference
+// implementation described by opts. newExecutor is called once per
+// worker (see Options.Concurrency) so no two fixtures ever share a
+// chop-side EVM instance. opts.Bytecode, opts.Calldata, and
+// opts.GasLimit are ignored; each fixture supplies its own. If
+// opts.Format is "json", the full report is written to
+// opts.OutputPath as JSON; otherwise each fixture's outcome is
+// printed to stdout in fixture order once every fixture has run.
+//
+// RunFixtures returns a non-nil error if any fixture mismatched, so
+// callers like `chop diff` can exit non-zero in CI.
+func RunFixtures(newExecutor func() evm.Executor, fxs []fixtures.Fixture, opts Options) ([]FixtureReport, error) {
+	runner, err := newReferenceRunner(opts)
+	if err != nil {
+		return nil, err
+	}
+	return runFixturesWithRunner(newExecutor, runner, fxs, opts)
+}
+
+// runFixturesWithRunner holds RunFixtures' logic with the reference
+// runner passed in directly, so tests can substitute a stub instead
+// of shelling out to a real revme/geth binary.
+func runFixturesWithRunner(newExecutor func() evm.Executor, runner referenceRunner, fxs []fixtures.Fixture, opts Options) ([]FixtureReport, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	if concurrency > len(fxs) {
+		concurrency = len(fxs)
+	}
+
+	reports := make([]FixtureReport, len(fxs))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			executor := newExecutor()
+			for i := range indices {
+				reports[i] = runFixture(executor, runner, fxs[i], opts)
+			}
+		}()
+	}
+	for i := range fxs {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	mismatches := 0
+	for _, report := range reports {
+		if !report.Matched {
+			mismatches++
+		}
+		printFixtureReport(opts, report)
+	}
+
+	return finishReport(reports, opts, mismatches)
+}
+
+func runFixture(executor evm.Executor, runner referenceRunner, fx fixtures.Fixture, opts Options) FixtureReport {
+	fxOpts := opts
+	fxOpts.Bytecode = fx.Bytecode
+	fxOpts.Calldata = fx.Calldata
+	fxOpts.GasLimit = fx.GasLimit
+
+	report := FixtureReport{Name: fx.Name, Fork: string(fxOpts.Fork)}
+	result, err := compare(executor, runner, fxOpts)
+	if err != nil {
+		report.Mismatch = err.Error()
+		return report
+	}
+	report.Matched = result.Matched
+	report.Mismatch = result.Mismatch
+	return report
+}
+
+func printFixtureReport(opts Options, report FixtureReport) {
+	if opts.Format == "json" {
+		return
+	}
+	if report.Matched {
+		fmt.Printf("PASS %s\n", report.Name)
+		return
+	}
+	fmt.Printf("FAIL %s: %s\n", report.Name, report.Mismatch)
+}
+
+func finishReport(reports []FixtureReport, opts Options, mismatches int) ([]FixtureReport, error) {
+	if opts.Format == "json" {
+		if err := writeReport(opts.OutputPath, reports); err != nil {
+			return reports, err
+		}
+	}
+	if mismatches > 0 {
+		return reports, fmt.Errorf("diff: %d of %d fixtures mismatched", mismatches, len(reports))
+	}
+	return reports, nil
+}
+
+func writeReport(path string, reports []FixtureReport) error {
+	data, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// RunCategory diffs every fixture found in dir (a flat directory of
+// "*.json" fixture files, as saved by fixtures.Save) against the
+// reference implementation. Results are always ordered by filename,
+// regardless of Options.Concurrency or which worker finishes first.
+func RunCategory(newExecutor func() evm.Executor, dir string, opts Options) ([]FixtureReport, error) {
+	runner, err := newReferenceRunner(opts)
+	if err != nil {
+		return nil, err
+	}
+	return runCategoryWithRunner(newExecutor, runner, dir, opts)
+}
+
+func runCategoryWithRunner(newExecutor func() evm.Executor, runner referenceRunner, dir string, opts Options) ([]FixtureReport, error) {
+	fxs, err := LoadCategory(dir)
+	if err != nil {
+		return nil, err
+	}
+	return runFixturesWithRunner(newExecutor, runner, fxs, opts)
+}
+
+// LoadCategory reads every "*.json" fixture file in dir (a flat
+// directory, as saved by fixtures.Save), in filename order. Exported
+// so callers that need the raw fixtures themselves, not just a diff
+// report, can reuse the same loader RunCategory does (e.g. `chop diff
+// --category --coverage`).
+func LoadCategory(dir string) ([]fixtures.Fixture, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("diff: reading category %q: %w", dir, err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == ".json" {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	fxs := make([]fixtures.Fixture, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var fx fixtures.Fixture
+		if err := json.Unmarshal(data, &fx); err != nil {
+			return nil, fmt.Errorf("diff: parsing fixture %q: %w", name, err)
+		}
+		fxs = append(fxs, fx)
+	}
+	return fxs, nil
+}
@@ -0,0 +1,141 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fixtures"
+	"github.com/evmts/chop/internal/types"
+)
+
+// matchingExecutor returns a CallResult equal to what echoRunner
+// reports (21,000 gas, output 0x01) for every fixture, except for
+// fixtures named in mismatchedNames, which get a wrong GasUsed to
+// force a divergence.
+type matchingExecutor struct {
+	mismatchedNames map[string]bool
+}
+
+func (e *matchingExecutor) ExecuteCall(ctx evm.ExecutionContext) (types.CallResult, error) {
+	gas := uint64(21_000)
+	if e.mismatchedNames[string(ctx.Input)] {
+		gas++
+	}
+	return types.CallResult{GasUsed: gas, Output: []byte{0x01}}, nil
+}
+
+// echoRunner reports the same gas/output (21,000 gas, output 0x01) for
+// every fixture, so matchingExecutor's per-fixture mismatch (keyed on
+// calldata) is the only source of divergence.
+type echoRunner struct{}
+
+func (echoRunner) Run(opts Options) (referenceResult, error) {
+	return referenceResult{GasUsed: 21_000, Output: []byte{0x01}}, nil
+}
+
+func TestRunFixturesReportsOneMismatchAmongMany(t *testing.T) {
+	fxs := []fixtures.Fixture{
+		{Name: "passes-one", Calldata: []byte("passes-one")},
+		{Name: "diverges", Calldata: []byte("diverges")},
+		{Name: "passes-two", Calldata: []byte("passes-two")},
+	}
+	executor := &matchingExecutor{mismatchedNames: map[string]bool{"diverges": true}}
+	newExecutor := func() evm.Executor { return executor }
+
+	reports, err := runFixturesWithRunner(newExecutor, echoRunner{}, fxs, Options{Reference: ReferenceRevme})
+	if err == nil {
+		t.Fatal("want an error when any fixture mismatches")
+	}
+	if len(reports) != 3 {
+		t.Fatalf("got %d reports, want 3", len(reports))
+	}
+	for _, r := range reports {
+		want := r.Name != "diverges"
+		if r.Matched != want {
+			t.Errorf("fixture %s: Matched=%v, want %v", r.Name, r.Matched, want)
+		}
+	}
+}
+
+func TestRunFixturesWritesJSONReport(t *testing.T) {
+	fxs := []fixtures.Fixture{{Name: "ok", Calldata: []byte("ok")}}
+	executor := &matchingExecutor{}
+	newExecutor := func() evm.Executor { return executor }
+	outPath := filepath.Join(t.TempDir(), "report.json")
+
+	if _, err := runFixturesWithRunner(newExecutor, echoRunner{}, fxs, Options{Reference: ReferenceRevme, Format: "json", OutputPath: outPath}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("reading report: %v", err)
+	}
+	var reports []FixtureReport
+	if err := json.Unmarshal(data, &reports); err != nil {
+		t.Fatalf("unmarshaling report: %v", err)
+	}
+	if len(reports) != 1 || !reports[0].Matched {
+		t.Errorf("got %+v, want one matched report", reports)
+	}
+}
+
+func TestRunCategoryLoadsFixturesFromDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, fixtures.Fixture{Name: "a", Calldata: []byte("a")})
+	writeFixture(t, dir, fixtures.Fixture{Name: "b", Calldata: []byte("b")})
+
+	executor := &matchingExecutor{}
+	newExecutor := func() evm.Executor { return executor }
+	reports, err := runCategoryWithRunner(newExecutor, echoRunner{}, dir, Options{Reference: ReferenceRevme})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("got %d reports, want 2", len(reports))
+	}
+}
+
+func TestRunFixturesParallelMatchesSerial(t *testing.T) {
+	var fxs []fixtures.Fixture
+	mismatched := map[string]bool{}
+	for i := 0; i < 12; i++ {
+		name := fmt.Sprintf("fixture-%02d", i)
+		fxs = append(fxs, fixtures.Fixture{Name: name, Calldata: []byte(name)})
+		if i%3 == 0 {
+			mismatched[name] = true
+		}
+	}
+
+	newExecutor := func() evm.Executor { return &matchingExecutor{mismatchedNames: mismatched} }
+
+	serial, serialErr := runFixturesWithRunner(newExecutor, echoRunner{}, fxs, Options{Reference: ReferenceRevme, Concurrency: 1})
+	parallel, parallelErr := runFixturesWithRunner(newExecutor, echoRunner{}, fxs, Options{Reference: ReferenceRevme, Concurrency: 8})
+
+	if (serialErr == nil) != (parallelErr == nil) {
+		t.Fatalf("serial err=%v, parallel err=%v", serialErr, parallelErr)
+	}
+	if len(serial) != len(parallel) {
+		t.Fatalf("got %d serial reports, %d parallel reports", len(serial), len(parallel))
+	}
+	for i := range serial {
+		if serial[i] != parallel[i] {
+			t.Errorf("report %d: serial=%+v, parallel=%+v", i, serial[i], parallel[i])
+		}
+	}
+}
+
+func writeFixture(t *testing.T, dir string, fx fixtures.Fixture) {
+	t.Helper()
+	data, err := json.Marshal(fx)
+	if err != nil {
+		t.Fatalf("marshaling fixture: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, fx.Name+".json"), data, 0o644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package diff
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// revmeRunner executes bytecode/calldata against revme
+// (bluealloy/revm's CLI), parsing its --json output for the final gas
+// usage and return data.
+type revmeRunner struct {
+	binary string // defaults to "revme" on PATH if empty
+}
+
+func (r *revmeRunner) binaryPath() string {
+	if r.binary != "" {
+		return r.binary
+	}
+	return "revme"
+}
+
+func (r *revmeRunner) Run(opts Options) (referenceResult, error) {
+	path, err := exec.LookPath(r.binaryPath())
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: revme binary not found (looked for %q) - install via `cargo install --git https://github.com/bluealloy/revm revme` or pass --reference-binary: %w", r.binaryPath(), err)
+	}
+
+	cmd := exec.Command(path, "evm",
+		"--code", "0x"+hex.EncodeToString(opts.Bytecode),
+		"--input", "0x"+hex.EncodeToString(opts.Calldata),
+		"--gas-limit", fmt.Sprintf("%d", opts.GasLimit),
+		"--json",
+	)
+	out, err := cmd.Output()
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: running revme: %w", err)
+	}
+	return parseRevmeOutput(out)
+}
+
+// revmeSummary is revme's --json mode's trailing summary line.
+type revmeSummary struct {
+	GasUsed uint64 `json:"gas_used"`
+	Output  string `json:"output"`
+}
+
+func parseRevmeOutput(out []byte) (referenceResult, error) {
+	var summary revmeSummary
+	if err := json.Unmarshal(lastJSONLine(out), &summary); err != nil {
+		return referenceResult{}, fmt.Errorf("diff: parsing revme output: %w", err)
+	}
+	output, err := hex.DecodeString(strings.TrimPrefix(summary.Output, "0x"))
+	if err != nil {
+		return referenceResult{}, fmt.Errorf("diff: decoding revme output: %w", err)
+	}
+	return referenceResult{GasUsed: summary.GasUsed, Output: output}, nil
+}
@@ -0,0 +1,43 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRevmeOutputReadsTrailingSummaryLine(t *testing.T) {
+	out := []byte(`{"pc":0,"opcode":"PUSH1"}
+{"gas_used":21064,"output":"0x0a"}
+`)
+	result, err := parseRevmeOutput(out)
+	if err != nil {
+		t.Fatalf("parseRevmeOutput: %v", err)
+	}
+	if result.GasUsed != 21064 {
+		t.Errorf("got gas %d, want 21064", result.GasUsed)
+	}
+	if string(result.Output) != "\x0a" {
+		t.Errorf("got output %x, want 0a", result.Output)
+	}
+}
+
+func TestParseRevmeOutputRejectsMalformedJSON(t *testing.T) {
+	if _, err := parseRevmeOutput([]byte("not json")); err == nil {
+		t.Error("want an error for malformed revme output")
+	}
+}
+
+func TestRevmeRunnerMissingBinaryReturnsInstallGuidance(t *testing.T) {
+	runner := &revmeRunner{binary: "chop-diff-test-no-such-revme-binary"}
+	_, err := runner.Run(Options{})
+	if err == nil {
+		t.Fatal("want an error when the revme binary isn't found")
+	}
+	got := err.Error()
+	if !strings.Contains(got, "binary not found") || !strings.Contains(got, "chop-diff-test-no-such-revme-binary") {
+		t.Errorf("got error %q, want it to name the missing binary", got)
+	}
+	if !strings.Contains(got, "cargo install") {
+		t.Errorf("got error %q, want install guidance", got)
+	}
+}
@@ -0,0 +1,345 @@
+package diff
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// specTestFile is the standard execution-spec-tests "state test"
+// schema: a JSON object keyed by test name, each holding one pre
+// state, one transaction (with per-index data/gas/value variants),
+// and one post-state section per fork that was run against.
+type specTestFile map[string]specTest
+
+type specTest struct {
+	Env         specTestEnv                  `json:"env"`
+	Pre         map[string]specTestAccount   `json:"pre"`
+	Transaction specTestTransaction          `json:"transaction"`
+	Post        map[string][]specTestPostRun `json:"post"`
+}
+
+type specTestEnv struct {
+	CurrentCoinbase  string `json:"currentCoinbase"`
+	CurrentGasLimit  string `json:"currentGasLimit"`
+	CurrentNumber    string `json:"currentNumber"`
+	CurrentTimestamp string `json:"currentTimestamp"`
+	CurrentBaseFee   string `json:"currentBaseFee"`
+	CurrentRandom    string `json:"currentRandom"`
+}
+
+type specTestAccount struct {
+	Balance string            `json:"balance"`
+	Code    string            `json:"code"`
+	Nonce   string            `json:"nonce"`
+	Storage map[string]string `json:"storage"`
+}
+
+type specTestTransaction struct {
+	Sender   string   `json:"sender"`
+	To       string   `json:"to"`
+	Data     []string `json:"data"`
+	GasLimit []string `json:"gasLimit"`
+	Value    []string `json:"value"`
+}
+
+// specTestPostRun is one (data, gas, value) combination run for a
+// fork, as recorded in a post-state section. ExpectException is set
+// instead of Hash/Logs when the transaction was expected to be
+// rejected outright (e.g. insufficient balance, bad nonce).
+type specTestPostRun struct {
+	Indexes struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+	ExpectException string `json:"expectException,omitempty"`
+}
+
+// SpecCase is one (test name, fork, index) combination extracted from
+// a spec-test file, ready to execute against chop.
+//
+// SpecCase only checks whether chop's execution status (success vs.
+// revert/failure) agrees with whether the case expected an exception;
+// it does not recompute or compare the post-state root hash recorded
+// in the fixture, since chop has no Merkle-Patricia trie
+// implementation. A case can therefore pass here while still
+// diverging from the reference client on state details.
+type SpecCase struct {
+	Name string
+	Fork string
+	ctx  evm.ExecutionContext
+	// wantFailure is true when the case's post-state entry carries an
+	// ExpectException, meaning the transaction itself must not apply.
+	wantFailure bool
+}
+
+// SpecCaseResult is the outcome of executing one SpecCase against chop.
+type SpecCaseResult struct {
+	Name   string
+	Fork   string
+	Passed bool
+	Reason string
+}
+
+// ParseSpecFixture parses a standard execution-spec-tests state-test
+// JSON file (possibly covering many test names and, within each, many
+// forks and many data/gas/value indices) into a flat list of
+// SpecCase. forkFilter, if non-empty, keeps only cases for the named
+// fork (case-insensitive, e.g. "cancun" matches "Cancun").
+func ParseSpecFixture(data []byte, forkFilter string) ([]SpecCase, error) {
+	var file specTestFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("diff: parsing spec fixture: %w", err)
+	}
+
+	names := make([]string, 0, len(file))
+	for name := range file {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var cases []SpecCase
+	for _, name := range names {
+		test := file[name]
+		forks := make([]string, 0, len(test.Post))
+		for fork := range test.Post {
+			forks = append(forks, fork)
+		}
+		sort.Strings(forks)
+
+		for _, fork := range forks {
+			if forkFilter != "" && !strings.EqualFold(fork, forkFilter) {
+				continue
+			}
+			for _, run := range test.Post[fork] {
+				c, err := buildSpecCase(name, fork, test, run)
+				if err != nil {
+					return nil, err
+				}
+				cases = append(cases, c)
+			}
+		}
+	}
+	return cases, nil
+}
+
+func buildSpecCase(name, fork string, test specTest, run specTestPostRun) (SpecCase, error) {
+	caseName := fmt.Sprintf("%s/%s/d%dg%dv%d", name, fork, run.Indexes.Data, run.Indexes.Gas, run.Indexes.Value)
+
+	sender, err := specHexToAddress(test.Transaction.Sender)
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: sender: %w", caseName, err)
+	}
+	to, err := specHexToAddress(test.Transaction.To)
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: to: %w", caseName, err)
+	}
+	input, err := specHexToBytes(indexInto(test.Transaction.Data, run.Indexes.Data))
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: data: %w", caseName, err)
+	}
+	gasLimit, err := specHexToUint64(indexInto(test.Transaction.GasLimit, run.Indexes.Gas))
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: gasLimit: %w", caseName, err)
+	}
+	value, err := specHexToUint64(indexInto(test.Transaction.Value, run.Indexes.Value))
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: value: %w", caseName, err)
+	}
+
+	preState, toCode, err := buildPreState(test.Pre)
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: pre: %w", caseName, err)
+	}
+
+	block, err := buildBlockContext(test.Env)
+	if err != nil {
+		return SpecCase{}, fmt.Errorf("diff: %s: env: %w", caseName, err)
+	}
+
+	return SpecCase{
+		Name: caseName,
+		Fork: fork,
+		ctx: evm.ExecutionContext{
+			From:     sender,
+			To:       &to,
+			Code:     toCode[to],
+			Input:    input,
+			Value:    value,
+			GasLimit: gasLimit,
+			Fork:     bytecode.Fork(strings.ToLower(fork)),
+			Block:    &block,
+			PreState: preState,
+		},
+		wantFailure: run.ExpectException != "",
+	}, nil
+}
+
+func buildPreState(pre map[string]specTestAccount) ([]types.PreStateEntry, map[types.Address][]byte, error) {
+	addrs := make([]string, 0, len(pre))
+	for addr := range pre {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	entries := make([]types.PreStateEntry, 0, len(pre))
+	code := make(map[types.Address][]byte, len(pre))
+	for _, addrHex := range addrs {
+		account := pre[addrHex]
+		addr, err := specHexToAddress(addrHex)
+		if err != nil {
+			return nil, nil, err
+		}
+		balance, err := specHexToUint64(account.Balance)
+		if err != nil {
+			return nil, nil, err
+		}
+		nonce, err := specHexToUint64(account.Nonce)
+		if err != nil {
+			return nil, nil, err
+		}
+		accCode, err := specHexToBytes(account.Code)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		storageKeys := make([]string, 0, len(account.Storage))
+		for key := range account.Storage {
+			storageKeys = append(storageKeys, key)
+		}
+		sort.Strings(storageKeys)
+		storage := make([]types.StorageSlot, 0, len(storageKeys))
+		for _, keyHex := range storageKeys {
+			key, err := specHexToHash(keyHex)
+			if err != nil {
+				return nil, nil, err
+			}
+			val, err := specHexToHash(account.Storage[keyHex])
+			if err != nil {
+				return nil, nil, err
+			}
+			storage = append(storage, types.StorageSlot{Key: key, Value: val})
+		}
+
+		entries = append(entries, types.PreStateEntry{
+			Address: addr,
+			Account: types.AccountState{Balance: balance, Nonce: nonce, Code: accCode, Storage: storage},
+		})
+		code[addr] = accCode
+	}
+	return entries, code, nil
+}
+
+func buildBlockContext(env specTestEnv) (types.BlockContext, error) {
+	var block types.BlockContext
+	var err error
+	if block.BlockNumber, err = specHexToUint64OrZero(env.CurrentNumber); err != nil {
+		return block, err
+	}
+	if block.Timestamp, err = specHexToUint64OrZero(env.CurrentTimestamp); err != nil {
+		return block, err
+	}
+	if block.BaseFee, err = specHexToUint64OrZero(env.CurrentBaseFee); err != nil {
+		return block, err
+	}
+	if block.Coinbase, err = specHexToAddress(env.CurrentCoinbase); err != nil {
+		return block, err
+	}
+	randao := env.CurrentRandom
+	if randao == "" {
+		randao = "0x0"
+	}
+	if block.PrevRandao, err = specHexToHash(randao); err != nil {
+		return block, err
+	}
+	return block, nil
+}
+
+// RunSpecCases executes every case in cases against executor and
+// reports whether its success/failure matches the case's expectation.
+func RunSpecCases(executor evm.Executor, cases []SpecCase) []SpecCaseResult {
+	results := make([]SpecCaseResult, len(cases))
+	for i, c := range cases {
+		result, err := executor.ExecuteCall(c.ctx)
+		if err != nil {
+			results[i] = SpecCaseResult{Name: c.Name, Fork: c.Fork, Passed: false, Reason: err.Error()}
+			continue
+		}
+		failed := !result.Status
+		if failed == c.wantFailure {
+			results[i] = SpecCaseResult{Name: c.Name, Fork: c.Fork, Passed: true}
+			continue
+		}
+		results[i] = SpecCaseResult{
+			Name:   c.Name,
+			Fork:   c.Fork,
+			Passed: false,
+			Reason: fmt.Sprintf("got status=%v, want failure=%v", result.Status, c.wantFailure),
+		}
+	}
+	return results
+}
+
+func indexInto(values []string, i int) string {
+	if i < 0 || i >= len(values) {
+		return "0x"
+	}
+	return values[i]
+}
+
+func specHexToBytes(s string) ([]byte, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	return hex.DecodeString(s)
+}
+
+func specHexToUint64(s string) (uint64, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 16, 64)
+}
+
+func specHexToUint64OrZero(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return specHexToUint64(s)
+}
+
+func specHexToAddress(s string) (types.Address, error) {
+	var addr types.Address
+	b, err := specHexToBytes(s)
+	if err != nil {
+		return addr, err
+	}
+	if len(b) > len(addr) {
+		return addr, fmt.Errorf("address %q is longer than 20 bytes", s)
+	}
+	copy(addr[len(addr)-len(b):], b)
+	return addr, nil
+}
+
+func specHexToHash(s string) (types.Hash, error) {
+	var h types.Hash
+	b, err := specHexToBytes(s)
+	if err != nil {
+		return h, err
+	}
+	if len(b) > len(h) {
+		return h, fmt.Errorf("hash %q is longer than 32 bytes", s)
+	}
+	copy(h[len(h)-len(b):], b)
+	return h, nil
+}
@@ -0,0 +1,150 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// trimmedStateTest is a minimal execution-spec-tests state test
+// covering two forks (Berlin and Cancun) for one test case, trimmed
+// down from the real fixture schema to just the fields chop's parser
+// reads.
+const trimmedStateTest = `{
+  "add_returns_sum": {
+    "env": {
+      "currentCoinbase": "0x2adc25665018aa1fe0e6bc666dac8fc2697ff9ba",
+      "currentGasLimit": "0x5f5e100",
+      "currentNumber": "0x1",
+      "currentTimestamp": "0x3e8",
+      "currentBaseFee": "0x7"
+    },
+    "pre": {
+      "0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b": {
+        "balance": "0xffffffffffffffff",
+        "code": "0x",
+        "nonce": "0x0",
+        "storage": {}
+      },
+      "0x1000000000000000000000000000000000000001": {
+        "balance": "0x0",
+        "code": "0x600160005260206000f3",
+        "nonce": "0x0",
+        "storage": {}
+      }
+    },
+    "transaction": {
+      "sender": "0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b",
+      "to": "0x1000000000000000000000000000000000000001",
+      "data": ["0x"],
+      "gasLimit": ["0x5208"],
+      "value": ["0x0", "0x1"]
+    },
+    "post": {
+      "Berlin": [
+        {"indexes": {"data": 0, "gas": 0, "value": 0}, "hash": "0xaaaa"}
+      ],
+      "Cancun": [
+        {"indexes": {"data": 0, "gas": 0, "value": 0}, "hash": "0xbbbb"},
+        {"indexes": {"data": 0, "gas": 0, "value": 1}, "expectException": "TR_NoFunds"}
+      ]
+    }
+  }
+}`
+
+func TestParseSpecFixtureCoversEveryForkByDefault(t *testing.T) {
+	cases, err := ParseSpecFixture([]byte(trimmedStateTest), "")
+	if err != nil {
+		t.Fatalf("ParseSpecFixture: %v", err)
+	}
+	if len(cases) != 3 {
+		t.Fatalf("got %d cases, want 3 (1 Berlin + 2 Cancun)", len(cases))
+	}
+	if cases[0].Fork != "Berlin" || cases[1].Fork != "Cancun" || cases[2].Fork != "Cancun" {
+		t.Errorf("got forks %q, %q, %q, want Berlin, Cancun, Cancun", cases[0].Fork, cases[1].Fork, cases[2].Fork)
+	}
+	if cases[1].wantFailure {
+		t.Error("want the plain Cancun case to not expect failure")
+	}
+	if !cases[2].wantFailure {
+		t.Error("want the expectException Cancun case to expect failure")
+	}
+}
+
+func TestParseSpecFixtureFiltersToOneFork(t *testing.T) {
+	cases, err := ParseSpecFixture([]byte(trimmedStateTest), "berlin")
+	if err != nil {
+		t.Fatalf("ParseSpecFixture: %v", err)
+	}
+	if len(cases) != 1 {
+		t.Fatalf("got %d cases, want 1", len(cases))
+	}
+	if cases[0].Fork != "Berlin" {
+		t.Errorf("got fork %q, want Berlin", cases[0].Fork)
+	}
+}
+
+func TestParseSpecFixtureDecodesCallParameters(t *testing.T) {
+	cases, err := ParseSpecFixture([]byte(trimmedStateTest), "berlin")
+	if err != nil {
+		t.Fatalf("ParseSpecFixture: %v", err)
+	}
+	ctx := cases[0].ctx
+	wantTo := types.Address{0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+	if ctx.To == nil || *ctx.To != wantTo {
+		t.Errorf("got to %v, want %v", ctx.To, wantTo)
+	}
+	if ctx.GasLimit != 0x5208 {
+		t.Errorf("got gasLimit %d, want %d", ctx.GasLimit, 0x5208)
+	}
+	if string(ctx.Fork) != "berlin" {
+		t.Errorf("got fork %q, want berlin", ctx.Fork)
+	}
+	if len(ctx.Code) == 0 {
+		t.Error("want the recipient's pre-state code wired in as ctx.Code")
+	}
+}
+
+// specStubExecutor reports success unless the call carries a nonzero
+// value, standing in for guillotine rejecting a transaction that
+// can't cover the value it sends (this fixture's expectException
+// case), so tests can drive both the pass and fail paths of
+// RunSpecCases without a real guillotine binary.
+type specStubExecutor struct{}
+
+func (specStubExecutor) ExecuteCall(ctx evm.ExecutionContext) (types.CallResult, error) {
+	return types.CallResult{Status: ctx.Value == 0}, nil
+}
+
+func TestRunSpecCasesPassesWhenStatusMatchesExpectation(t *testing.T) {
+	cases, err := ParseSpecFixture([]byte(trimmedStateTest), "")
+	if err != nil {
+		t.Fatalf("ParseSpecFixture: %v", err)
+	}
+
+	results := RunSpecCases(specStubExecutor{}, cases)
+	if len(results) != len(cases) {
+		t.Fatalf("got %d results, want %d", len(results), len(cases))
+	}
+	for _, r := range results {
+		if !r.Passed {
+			t.Errorf("case %s: got Passed=false, reason %q", r.Name, r.Reason)
+		}
+	}
+}
+
+func TestRunSpecCasesFailsOnStatusMismatch(t *testing.T) {
+	cases, err := ParseSpecFixture([]byte(trimmedStateTest), "berlin")
+	if err != nil {
+		t.Fatalf("ParseSpecFixture: %v", err)
+	}
+	// Force a mismatch: mark the Berlin case as expecting failure,
+	// while the stub executor reports success for its zero-value call.
+	cases[0].wantFailure = true
+
+	results := RunSpecCases(specStubExecutor{}, cases)
+	if results[0].Passed {
+		t.Error("want the case to fail when status doesn't match the expectation")
+	}
+}
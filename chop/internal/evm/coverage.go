@@ -0,0 +1,62 @@
+package evm
+
+import (
+	"sort"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+// Coverage accumulates which opcodes have been exercised across one or
+// more traces, and how many times each one ran. `chop diff --category`
+// and `chop load-fixture --all` use it (with --coverage) to report how
+// much of a fork's opcode surface a fixture suite actually exercises.
+type Coverage struct {
+	counts map[string]int
+}
+
+// NewCoverage returns an empty Coverage collector.
+func NewCoverage() *Coverage {
+	return &Coverage{counts: make(map[string]int)}
+}
+
+// Record adds every step of trace to the collector.
+func (c *Coverage) Record(trace []TraceStep) {
+	for _, step := range trace {
+		c.counts[step.Op]++
+	}
+}
+
+// Counts returns the collector's per-opcode hit count.
+func (c *Coverage) Counts() map[string]int {
+	return c.counts
+}
+
+// CoverageReport summarizes a Coverage against the opcodes available at
+// a given fork.
+type CoverageReport struct {
+	Fork      bytecode.Fork
+	Covered   int
+	Total     int
+	Counts    map[string]int
+	Uncovered []string
+}
+
+// Report compares c's recorded opcodes against every opcode
+// bytecode.Opcodes lists as available at fork, splitting them into
+// covered and uncovered.
+func (c *Coverage) Report(fork bytecode.Fork) CoverageReport {
+	report := CoverageReport{Fork: fork, Counts: c.counts}
+	for _, op := range bytecode.Opcodes {
+		if !op.AvailableAt(fork) {
+			continue
+		}
+		report.Total++
+		if c.counts[op.Name] > 0 {
+			report.Covered++
+		} else {
+			report.Uncovered = append(report.Uncovered, op.Name)
+		}
+	}
+	sort.Strings(report.Uncovered)
+	return report
+}
@@ -0,0 +1,49 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+func TestCoverageReportsExactlyRecordedOpcodes(t *testing.T) {
+	c := NewCoverage()
+	c.Record([]TraceStep{
+		{PC: 0, Op: "PUSH1"},
+		{PC: 2, Op: "PUSH1"},
+		{PC: 4, Op: "SSTORE"},
+	})
+
+	counts := c.Counts()
+	if counts["PUSH1"] != 2 || counts["SSTORE"] != 1 {
+		t.Errorf("got counts %v, want PUSH1=2 SSTORE=1", counts)
+	}
+
+	report := c.Report(bytecode.LatestFork)
+	if report.Covered != 2 {
+		t.Errorf("got covered=%d, want 2 (PUSH1, SSTORE)", report.Covered)
+	}
+	for _, uncovered := range report.Uncovered {
+		if uncovered == "PUSH1" || uncovered == "SSTORE" {
+			t.Errorf("got %q in uncovered list, want it covered", uncovered)
+		}
+	}
+	if report.Total <= report.Covered {
+		t.Errorf("got total=%d covered=%d, want total > covered for a fixture exercising only two opcodes", report.Total, report.Covered)
+	}
+}
+
+func TestCoverageReportOmitsOpcodesNotAvailableAtFork(t *testing.T) {
+	c := NewCoverage()
+	c.Record([]TraceStep{{PC: 0, Op: "PUSH0"}})
+
+	report := c.Report(bytecode.Frontier)
+	for _, uncovered := range report.Uncovered {
+		if uncovered == "PUSH0" {
+			t.Error("got PUSH0 listed as uncovered at Frontier, want it excluded since it didn't exist yet")
+		}
+	}
+	if report.Covered != 0 {
+		t.Errorf("got covered=%d, want 0 since PUSH0 isn't available at Frontier", report.Covered)
+	}
+}
@@ -0,0 +1,57 @@
+package evm
+
+import "github.com/evmts/chop/internal/types"
+
+// PredictCreateAddress computes the address a contract deployed via a
+// plain CREATE from deployer at the given nonce will end up at: the
+// low 20 bytes of keccak256(RLP([deployer, nonce])).
+func PredictCreateAddress(deployer types.Address, nonce uint64) types.Address {
+	payload := rlpBytes(deployer[:])
+	payload = append(payload, rlpUint(nonce)...)
+	list := append(rlpListHeader(len(payload)), payload...)
+
+	hash := keccak256(list)
+	var addr types.Address
+	copy(addr[:], hash[12:])
+	return addr
+}
+
+// rlpBytes RLP-encodes a byte string: a single byte under 0x80 encodes
+// as itself, otherwise as a length header followed by the bytes.
+func rlpBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(rlpStringHeader(len(b)), b...)
+}
+
+// rlpUint RLP-encodes a non-negative integer as its minimal big-endian
+// byte string, per RLP's integer convention — 0 encodes as an empty
+// string (0x80), not a zero byte, and leading zero bytes are dropped.
+func rlpUint(n uint64) []byte {
+	if n == 0 {
+		return []byte{0x80}
+	}
+	var b []byte
+	for shift := 56; shift >= 0; shift -= 8 {
+		v := byte(n >> shift)
+		if v != 0 || len(b) > 0 {
+			b = append(b, v)
+		}
+	}
+	return rlpBytes(b)
+}
+
+// rlpStringHeader returns the RLP length header for a byte string of
+// length n, assuming n < 56: true for both our 20-byte address and
+// any uint64 nonce, which never encodes to more than 8 bytes.
+func rlpStringHeader(n int) []byte {
+	return []byte{byte(0x80 + n)}
+}
+
+// rlpListHeader returns the RLP length header for a list payload of
+// length n, assuming n < 56: true here, since an RLP-encoded address
+// plus nonce is at most 21+9 = 30 bytes.
+func rlpListHeader(n int) []byte {
+	return []byte{byte(0xc0 + n)}
+}
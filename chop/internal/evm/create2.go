@@ -0,0 +1,35 @@
+package evm
+
+import (
+	"golang.org/x/crypto/sha3"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// PredictCreate2Address computes the address a CREATE2 deployment from
+// deployer with the given salt and initCode will end up at, per
+// EIP-1014: keccak256(0xff ++ deployer ++ salt ++ keccak256(initCode))[12:].
+// It doesn't execute anything — callers can show this in the call
+// parameter view before a CREATE2 call runs.
+func PredictCreate2Address(deployer types.Address, salt types.Hash, initCode []byte) types.Address {
+	initCodeHash := keccak256(initCode)
+
+	payload := make([]byte, 0, 1+len(deployer)+len(salt)+len(initCodeHash))
+	payload = append(payload, 0xff)
+	payload = append(payload, deployer[:]...)
+	payload = append(payload, salt[:]...)
+	payload = append(payload, initCodeHash...)
+
+	hash := keccak256(payload)
+	var addr types.Address
+	copy(addr[:], hash[12:])
+	return addr
+}
+
+// keccak256 hashes data with Ethereum's Keccak256 (the pre-standardization
+// variant sha3.NewLegacyKeccak256 implements, not NIST SHA3).
+func keccak256(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,88 @@
+package evm
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// These vectors are EIP-1014's published CREATE2 examples, with a
+// zero deployer/salt/init-code baseline and one case each varying the
+// deployer and the init code.
+func TestPredictCreate2AddressMatchesEIP1014Vectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		deployer string
+		salt     string
+		initCode string
+		want     string
+	}{
+		{
+			name:     "zero deployer, zero salt, single-byte init code",
+			deployer: "0000000000000000000000000000000000000000",
+			salt:     "0000000000000000000000000000000000000000000000000000000000000000",
+			initCode: "00",
+			want:     "4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38",
+		},
+		{
+			name:     "non-zero deployer, zero salt, single-byte init code",
+			deployer: "deadbeef00000000000000000000000000000000",
+			salt:     "0000000000000000000000000000000000000000000000000000000000000000",
+			initCode: "00",
+			want:     "b928f69bb1d91cd65274e3c79d8986362984fda3",
+		},
+		{
+			name:     "zero deployer, zero salt, 4-byte init code",
+			deployer: "0000000000000000000000000000000000000000",
+			salt:     "0000000000000000000000000000000000000000000000000000000000000000",
+			initCode: "deadbeef",
+			want:     "70f2b2914a2a4b783faefb75f459a580616fcb5e",
+		},
+		{
+			name:     "zero deployer, zero salt, empty init code",
+			deployer: "0000000000000000000000000000000000000000",
+			salt:     "0000000000000000000000000000000000000000000000000000000000000000",
+			initCode: "",
+			want:     "e33c0c7f7df4809055c3eba6c09cfe4baf1bd9e0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployer := mustAddress(t, tt.deployer)
+			salt := mustHash(t, tt.salt)
+			initCode, err := hex.DecodeString(tt.initCode)
+			if err != nil {
+				t.Fatalf("decoding init code: %v", err)
+			}
+
+			got := PredictCreate2Address(deployer, salt, initCode)
+			if got := hex.EncodeToString(got[:]); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustAddress(t *testing.T, s string) types.Address {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 20 {
+		t.Fatalf("mustAddress(%q): %v", s, err)
+	}
+	var addr types.Address
+	copy(addr[:], b)
+	return addr
+}
+
+func mustHash(t *testing.T, s string) types.Hash {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 32 {
+		t.Fatalf("mustHash(%q): %v", s, err)
+	}
+	var h types.Hash
+	copy(h[:], b)
+	return h
+}
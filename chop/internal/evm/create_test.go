@@ -0,0 +1,45 @@
+package evm
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// want values are keccak256(RLP([deployer, nonce]))[12:], cross-checked
+// against a known nonce-0 CREATE address derivation; 127/128 cover the
+// RLP single-byte/length-prefixed integer boundary.
+func TestPredictCreateAddressAcrossNonces(t *testing.T) {
+	deployer := mustAddress(t, "6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+
+	tests := []struct {
+		nonce uint64
+		want  string
+	}{
+		{0, "cd234a471b72ba2f1ccf0a70fcaba648a5eecd8d"},
+		{1, "343c43a37d37dff08ae8c4a11544c718abb4fcf8"},
+		{127, "06d9a77f5e4b311bae8d559db9cdb4df94104aa0"},
+		{128, "08e190dcb7b73f5fcdabb43e102215c83659a76d"},
+	}
+
+	for _, tt := range tests {
+		got := PredictCreateAddress(deployer, tt.nonce)
+		if got := hex.EncodeToString(got[:]); got != tt.want {
+			t.Errorf("nonce %d: got %s, want %s", tt.nonce, got, tt.want)
+		}
+	}
+}
+
+func TestRLPUintEncodesTheZeroNonceAsAnEmptyString(t *testing.T) {
+	if got := rlpUint(0); len(got) != 1 || got[0] != 0x80 {
+		t.Errorf("got %x, want [0x80]", got)
+	}
+}
+
+func TestRLPUintSingleByteBoundary(t *testing.T) {
+	if got := rlpUint(127); len(got) != 1 || got[0] != 0x7f {
+		t.Errorf("got %x, want [0x7f] (encoded as itself, no length prefix)", got)
+	}
+	if got := rlpUint(128); len(got) != 2 || got[0] != 0x81 || got[1] != 0x80 {
+		t.Errorf("got %x, want [0x81 0x80] (length-prefixed, since 0x80 >= 0x80)", got)
+	}
+}
@@ -0,0 +1,212 @@
+// Package evm drives EVM execution by shelling out to the guillotine
+// binary that `chop build` produces. chop itself does not implement
+// an EVM interpreter.
+package evm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/types"
+)
+
+// ExecutionContext describes a single call to execute: sender, target
+// (nil for contract creation), the code and input to run, and the gas
+// limit/fork to run it under.
+type ExecutionContext struct {
+	From     types.Address
+	To       *types.Address
+	Code     []byte
+	Input    []byte
+	Value    uint64
+	GasLimit uint64
+	Fork     bytecode.Fork
+
+	// Block pins the block environment to execute against. Nil means
+	// guillotine's own defaults.
+	Block *types.BlockContext
+	// PreState seeds account balances/nonces/code/storage before the
+	// call executes.
+	PreState []types.PreStateEntry
+	// AccessList pre-warms addresses and storage slots (EIP-2930), so
+	// their first SLOAD/account access within the call is charged the
+	// warm gas cost rather than the cold one. Only affects Berlin+
+	// forks, which are the only ones with warm/cold access costs.
+	AccessList []types.AccessListEntry
+	// MaxFeePerGas and MaxPriorityFeePerGas are the EIP-1559 fee caps
+	// a transaction sets; ExecuteCall uses them together with
+	// Block.BaseFee to compute CallResult's EffectiveGasPrice and
+	// PriorityFeePaid. Leaving both zero skips fee computation
+	// entirely (both come back zero), for calls that don't care about
+	// fees.
+	MaxFeePerGas         uint64
+	MaxPriorityFeePerGas uint64
+	// BlobVersionedHashes supplies the versioned hashes of a blob
+	// transaction's blobs (EIP-4844), so the BLOBHASH opcode returns
+	// real values instead of zero. Each hash must start with the 0x01
+	// version byte; ExecuteCall rejects any that don't.
+	BlobVersionedHashes []types.Hash
+	// EmitPostState asks guillotine to report every touched account's
+	// balance/nonce/storage after execution, via types.CallResult's
+	// PostState field. VMManager sets this when persistent state is
+	// enabled; it costs nothing extra to leave unset otherwise.
+	EmitPostState bool
+	// CaptureState asks guillotine to report the final stack and
+	// memory contents at the moment execution stopped, via
+	// types.CallResult's FinalStack/FinalMemory fields. Useful for
+	// inspecting a REVERT without re-running under --trace.
+	CaptureState bool
+}
+
+// Executor runs a single ExecutionContext and returns its outcome.
+// *EVM is the only production implementation; callers that need to
+// execute calls without shelling out (e.g. server tests) can satisfy
+// this with a fake.
+type Executor interface {
+	ExecuteCall(ctx ExecutionContext) (types.CallResult, error)
+}
+
+// ReadOnlyExecutor is satisfied by an Executor (such as *VMManager)
+// that can also run a call without persisting its effects. Callers
+// that need a guaranteed dry-run (eth_call, TUI previews) should
+// type-assert for this rather than relying on ExecuteCall alone, since
+// a plain Executor gives no such guarantee.
+type ReadOnlyExecutor interface {
+	Executor
+	ExecuteCallReadOnly(ctx ExecutionContext) (types.CallResult, error)
+}
+
+// EVM executes calls against a built guillotine binary.
+type EVM struct {
+	// Binary is the path to the guillotine executable produced by
+	// `chop build`.
+	Binary string
+}
+
+// NewEVM returns an EVM that executes calls via the guillotine binary
+// at binary.
+func NewEVM(binary string) *EVM {
+	return &EVM{Binary: binary}
+}
+
+func (e *EVM) baseArgs(ctx ExecutionContext) ([]string, error) {
+	args := []string{
+		"run",
+		"--code", "0x" + hex.EncodeToString(ctx.Code),
+		"--input", "0x" + hex.EncodeToString(ctx.Input),
+		"--gas-limit", fmt.Sprint(ctx.GasLimit),
+		"--fork", string(ctx.Fork),
+		"--format", "json",
+	}
+	if ctx.To != nil {
+		args = append(args, "--to", "0x"+hex.EncodeToString(ctx.To[:]))
+	}
+	if ctx.Block != nil {
+		args = append(args,
+			"--chain-id", fmt.Sprint(ctx.Block.ChainID),
+			"--block-number", fmt.Sprint(ctx.Block.BlockNumber),
+			"--timestamp", fmt.Sprint(ctx.Block.Timestamp),
+			"--base-fee", fmt.Sprint(ctx.Block.BaseFee),
+			"--coinbase", "0x"+hex.EncodeToString(ctx.Block.Coinbase[:]),
+			"--prevrandao", "0x"+hex.EncodeToString(ctx.Block.PrevRandao[:]),
+		)
+	}
+	if len(ctx.PreState) > 0 {
+		preState, err := json.Marshal(ctx.PreState)
+		if err != nil {
+			return nil, fmt.Errorf("encoding pre-state: %w", err)
+		}
+		args = append(args, "--prestate", string(preState))
+	}
+	if len(ctx.AccessList) > 0 {
+		accessList, err := json.Marshal(ctx.AccessList)
+		if err != nil {
+			return nil, fmt.Errorf("encoding access list: %w", err)
+		}
+		args = append(args, "--access-list", string(accessList))
+	}
+	if len(ctx.BlobVersionedHashes) > 0 {
+		blobHashes, err := json.Marshal(ctx.BlobVersionedHashes)
+		if err != nil {
+			return nil, fmt.Errorf("encoding blob versioned hashes: %w", err)
+		}
+		args = append(args, "--blob-hashes", string(blobHashes))
+	}
+	if ctx.EmitPostState {
+		args = append(args, "--emit-post-state")
+	}
+	if ctx.CaptureState {
+		args = append(args, "--capture-state")
+	}
+	return args, nil
+}
+
+// effectiveGasPrice computes what ctx actually pays per unit of gas
+// under EIP-1559: the block's base fee plus a priority fee capped at
+// both MaxPriorityFeePerGas and the headroom MaxFeePerGas leaves above
+// the base fee. Returns zero, zero, nil when ctx doesn't set any fee
+// caps, since plenty of calls (chop call with no flags, eth_call) don't
+// care about fees. Returns an error when MaxFeePerGas can't cover the
+// base fee.
+func effectiveGasPrice(ctx ExecutionContext) (price, priorityFeePaid uint64, err error) {
+	if ctx.MaxFeePerGas == 0 && ctx.MaxPriorityFeePerGas == 0 {
+		return 0, 0, nil
+	}
+	var baseFee uint64
+	if ctx.Block != nil {
+		baseFee = ctx.Block.BaseFee
+	}
+	if ctx.MaxFeePerGas < baseFee {
+		return 0, 0, fmt.Errorf("max fee per gas %d is less than base fee %d", ctx.MaxFeePerGas, baseFee)
+	}
+	priorityFeePaid = ctx.MaxPriorityFeePerGas
+	if headroom := ctx.MaxFeePerGas - baseFee; priorityFeePaid > headroom {
+		priorityFeePaid = headroom
+	}
+	return baseFee + priorityFeePaid, priorityFeePaid, nil
+}
+
+// blobVersionedHashVersion is the leading byte every EIP-4844 blob
+// versioned hash must carry, identifying it as a SHA-256-derived
+// commitment hash rather than some other hash scheme.
+const blobVersionedHashVersion = 0x01
+
+// validateBlobVersionedHashes rejects any hash not starting with
+// blobVersionedHashVersion.
+func validateBlobVersionedHashes(hashes []types.Hash) error {
+	for i, h := range hashes {
+		if h[0] != blobVersionedHashVersion {
+			return fmt.Errorf("blob versioned hash %d: want version byte 0x%02x, got 0x%02x", i, blobVersionedHashVersion, h[0])
+		}
+	}
+	return nil
+}
+
+// ExecuteCall runs ctx through guillotine and returns the outcome.
+func (e *EVM) ExecuteCall(ctx ExecutionContext) (types.CallResult, error) {
+	if err := validateBlobVersionedHashes(ctx.BlobVersionedHashes); err != nil {
+		return types.CallResult{}, err
+	}
+	price, priorityFeePaid, err := effectiveGasPrice(ctx)
+	if err != nil {
+		return types.CallResult{}, err
+	}
+	args, err := e.baseArgs(ctx)
+	if err != nil {
+		return types.CallResult{}, err
+	}
+	out, err := exec.Command(e.Binary, args...).Output()
+	if err != nil {
+		return types.CallResult{}, fmt.Errorf("guillotine execution failed: %w", err)
+	}
+	var result types.CallResult
+	if err := json.Unmarshal(out, &result); err != nil {
+		return types.CallResult{}, fmt.Errorf("parsing guillotine output: %w", err)
+	}
+	result.EffectiveGasPrice = price
+	result.PriorityFeePaid = priorityFeePaid
+	return result.DecodeRevert(), nil
+}
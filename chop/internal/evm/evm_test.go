@@ -0,0 +1,160 @@
+package evm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestBaseArgsOmitsCaptureStateByDefault(t *testing.T) {
+	e := NewEVM("guillotine")
+	args, err := e.baseArgs(ExecutionContext{Fork: "cancun"})
+	if err != nil {
+		t.Fatalf("baseArgs: %v", err)
+	}
+	if contains(args, "--capture-state") {
+		t.Errorf("got %v, want no --capture-state flag by default", args)
+	}
+}
+
+func TestBaseArgsAddsCaptureStateFlag(t *testing.T) {
+	e := NewEVM("guillotine")
+	args, err := e.baseArgs(ExecutionContext{Fork: "cancun", CaptureState: true})
+	if err != nil {
+		t.Fatalf("baseArgs: %v", err)
+	}
+	if !contains(args, "--capture-state") {
+		t.Errorf("got %v, want --capture-state flag", args)
+	}
+}
+
+func TestBaseArgsOmitsAccessListByDefault(t *testing.T) {
+	e := NewEVM("guillotine")
+	args, err := e.baseArgs(ExecutionContext{Fork: "cancun"})
+	if err != nil {
+		t.Fatalf("baseArgs: %v", err)
+	}
+	if contains(args, "--access-list") {
+		t.Errorf("got %v, want no --access-list flag by default", args)
+	}
+}
+
+func TestBaseArgsAddsAccessListFlag(t *testing.T) {
+	e := NewEVM("guillotine")
+	args, err := e.baseArgs(ExecutionContext{
+		Fork: "cancun",
+		AccessList: []types.AccessListEntry{
+			{Address: types.Address{0xaa}, StorageKeys: []types.Hash{{0x01}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("baseArgs: %v", err)
+	}
+	if !contains(args, "--access-list") {
+		t.Errorf("got %v, want --access-list flag", args)
+	}
+}
+
+func TestEffectiveGasPriceSkipsComputationWhenFeesUnset(t *testing.T) {
+	price, priorityFeePaid, err := effectiveGasPrice(ExecutionContext{
+		Block: &types.BlockContext{BaseFee: 10},
+	})
+	if err != nil {
+		t.Fatalf("effectiveGasPrice: %v", err)
+	}
+	if price != 0 || priorityFeePaid != 0 {
+		t.Errorf("got price=%d priorityFeePaid=%d, want 0, 0", price, priorityFeePaid)
+	}
+}
+
+func TestEffectiveGasPriceCapsPriorityFeeAtHeadroom(t *testing.T) {
+	price, priorityFeePaid, err := effectiveGasPrice(ExecutionContext{
+		Block:                &types.BlockContext{BaseFee: 100},
+		MaxFeePerGas:         120,
+		MaxPriorityFeePerGas: 50,
+	})
+	if err != nil {
+		t.Fatalf("effectiveGasPrice: %v", err)
+	}
+	if price != 120 || priorityFeePaid != 20 {
+		t.Errorf("got price=%d priorityFeePaid=%d, want 120, 20", price, priorityFeePaid)
+	}
+}
+
+func TestEffectiveGasPriceUsesFullPriorityFeeWhenRoomAllows(t *testing.T) {
+	price, priorityFeePaid, err := effectiveGasPrice(ExecutionContext{
+		Block:                &types.BlockContext{BaseFee: 100},
+		MaxFeePerGas:         200,
+		MaxPriorityFeePerGas: 10,
+	})
+	if err != nil {
+		t.Fatalf("effectiveGasPrice: %v", err)
+	}
+	if price != 110 || priorityFeePaid != 10 {
+		t.Errorf("got price=%d priorityFeePaid=%d, want 110, 10", price, priorityFeePaid)
+	}
+}
+
+func TestEffectiveGasPriceRejectsMaxFeeBelowBaseFee(t *testing.T) {
+	_, _, err := effectiveGasPrice(ExecutionContext{
+		Block:                &types.BlockContext{BaseFee: 100},
+		MaxFeePerGas:         50,
+		MaxPriorityFeePerGas: 5,
+	})
+	if err == nil {
+		t.Fatal("got nil error, want one for max fee below base fee")
+	}
+}
+
+// BLOBHASH(0) returning a supplied hash can only be verified against the
+// real guillotine binary, which isn't available here (see
+// TestBaseArgsAddsAccessListFlag for the same constraint with access
+// lists); these tests cover the flag threading and validation chop
+// itself is responsible for.
+func TestBaseArgsOmitsBlobHashesByDefault(t *testing.T) {
+	e := NewEVM("guillotine")
+	args, err := e.baseArgs(ExecutionContext{Fork: "cancun"})
+	if err != nil {
+		t.Fatalf("baseArgs: %v", err)
+	}
+	if contains(args, "--blob-hashes") {
+		t.Errorf("got %v, want no --blob-hashes flag by default", args)
+	}
+}
+
+func TestBaseArgsAddsBlobHashesFlag(t *testing.T) {
+	e := NewEVM("guillotine")
+	hash := types.Hash{0x01, 0xaa}
+	args, err := e.baseArgs(ExecutionContext{
+		Fork:                "cancun",
+		BlobVersionedHashes: []types.Hash{hash},
+	})
+	if err != nil {
+		t.Fatalf("baseArgs: %v", err)
+	}
+	if !contains(args, "--blob-hashes") {
+		t.Errorf("got %v, want --blob-hashes flag", args)
+	}
+}
+
+func TestValidateBlobVersionedHashesAcceptsVersionByte(t *testing.T) {
+	if err := validateBlobVersionedHashes([]types.Hash{{0x01, 0xaa}}); err != nil {
+		t.Errorf("got error %v, want nil for a 0x01-versioned hash", err)
+	}
+}
+
+func TestValidateBlobVersionedHashesRejectsWrongVersion(t *testing.T) {
+	if err := validateBlobVersionedHashes([]types.Hash{{0x02, 0xaa}}); err == nil {
+		t.Error("got nil error, want one for a non-0x01-versioned hash")
+	}
+}
+
+func contains(args []string, want string) bool {
+	for _, a := range args {
+		if strings.EqualFold(a, want) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package evm
+
+import "github.com/evmts/chop/internal/bytecode"
+
+// GasProfile aggregates a trace's gas usage by opcode category
+// (arithmetic, storage, memory, calls, other), so a call result can
+// show where its gas actually went instead of just the total.
+//
+// Each step's cost is the drop in GasRemaining between it and the
+// step after it; the trace's last step has no successor to diff
+// against, so it isn't attributed to any category (consistent with
+// the tracer reporting gas left, not gas spent, per step).
+func GasProfile(trace []TraceStep) map[string]uint64 {
+	profile := make(map[string]uint64, len(bytecode.GasCategories))
+	for i := 0; i < len(trace)-1; i++ {
+		if trace[i+1].GasRemaining > trace[i].GasRemaining {
+			continue // malformed/out-of-order trace; skip rather than underflow
+		}
+		cost := trace[i].GasRemaining - trace[i+1].GasRemaining
+
+		category := bytecode.CategoryOther
+		if op, ok := bytecode.ByName(trace[i].Op); ok {
+			category = op.Category()
+		}
+		profile[category] += cost
+	}
+	return profile
+}
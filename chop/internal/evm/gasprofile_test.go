@@ -0,0 +1,66 @@
+package evm
+
+import "testing"
+
+func TestGasProfileGroupsStepsByCategory(t *testing.T) {
+	trace := []TraceStep{
+		{Op: "PUSH1", GasRemaining: 1000},
+		{Op: "ADD", GasRemaining: 997},  // PUSH1 cost: 3, category other
+		{Op: "SLOAD", GasRemaining: 994}, // ADD cost: 3, category arithmetic
+		{Op: "STOP", GasRemaining: 894},  // SLOAD cost: 100, category storage
+	}
+
+	profile := GasProfile(trace)
+	if got := profile["arithmetic"]; got != 3 {
+		t.Errorf("got arithmetic %d, want 3", got)
+	}
+	if got := profile["storage"]; got != 100 {
+		t.Errorf("got storage %d, want 100", got)
+	}
+	if got := profile["other"]; got != 3 {
+		t.Errorf("got other %d, want 3", got)
+	}
+}
+
+func TestGasProfileStorageHeavyBytecodeReportsMostGasUnderStorage(t *testing.T) {
+	trace := []TraceStep{
+		{Op: "PUSH1", GasRemaining: 100000},
+		{Op: "SSTORE", GasRemaining: 99997}, // PUSH1 cost: 3, category other
+		{Op: "PUSH1", GasRemaining: 79997},  // SSTORE cost: 20000, category storage
+		{Op: "SSTORE", GasRemaining: 79994}, // PUSH1 cost: 3, category other
+		{Op: "STOP", GasRemaining: 59994},   // SSTORE cost: 20000, category storage
+	}
+
+	profile := GasProfile(trace)
+	var max uint64
+	var maxCategory string
+	for category, gas := range profile {
+		if gas > max {
+			max = gas
+			maxCategory = category
+		}
+	}
+	if maxCategory != "storage" {
+		t.Errorf("got max category %q, want storage (profile: %v)", maxCategory, profile)
+	}
+}
+
+func TestGasProfileLastStepIsNotAttributed(t *testing.T) {
+	trace := []TraceStep{
+		{Op: "ADD", GasRemaining: 100},
+	}
+	if profile := GasProfile(trace); len(profile) != 0 {
+		t.Errorf("got %v, want an empty profile for a single-step trace", profile)
+	}
+}
+
+func TestGasProfileUnknownOpcodeFallsBackToOther(t *testing.T) {
+	trace := []TraceStep{
+		{Op: "NOTAREALOPCODE", GasRemaining: 100},
+		{Op: "NOTAREALOPCODE", GasRemaining: 95},
+	}
+	profile := GasProfile(trace)
+	if got := profile["other"]; got != 5 {
+		t.Errorf("got other %d, want 5", got)
+	}
+}
@@ -0,0 +1,45 @@
+package evm
+
+import "github.com/evmts/chop/internal/bytecode"
+
+// SupportedHardforks lists every hardfork chop understands, oldest to
+// newest, for validating user-supplied --hardfork/--fork flags.
+func SupportedHardforks() []string {
+	forks := bytecode.KnownForks()
+	names := make([]string, len(forks))
+	for i, f := range forks {
+		names[i] = string(f)
+	}
+	return names
+}
+
+// IsValidHardfork reports whether name is one of SupportedHardforks.
+func IsValidHardfork(name string) bool {
+	for _, f := range SupportedHardforks() {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hardforkNotes gives a one-line activation note for each supported
+// hardfork, printed by `chop call --list-forks`.
+var hardforkNotes = map[string]string{
+	"frontier":          "Ethereum's original 2015 launch rules",
+	"tangerine-whistle": "EIP-150: repriced IO-heavy opcodes",
+	"byzantium":         "REVERT and STATICCALL",
+	"constantinople":    "CREATE2 and bitwise shift opcodes",
+	"istanbul":          "EIP-2200 SSTORE gas metering, BLAKE2 precompile",
+	"berlin":            "EIP-2929 access lists, cold/warm gas pricing",
+	"london":            "EIP-1559 fee market, BASEFEE opcode",
+	"merge":             "Proof-of-stake transition, PREVRANDAO",
+	"shanghai":          "Withdrawals, PUSH0 opcode",
+	"cancun":            "EIP-4844 blobs, transient storage, MCOPY",
+}
+
+// HardforkNote returns the activation note for fork, or "" if fork
+// isn't one of SupportedHardforks.
+func HardforkNote(fork string) string {
+	return hardforkNotes[fork]
+}
@@ -0,0 +1,36 @@
+package evm
+
+import "testing"
+
+func TestIsValidHardforkAcceptsCancun(t *testing.T) {
+	if !IsValidHardfork("cancun") {
+		t.Error("want cancun to be a supported hardfork")
+	}
+}
+
+func TestIsValidHardforkRejectsUnknownFork(t *testing.T) {
+	if IsValidHardfork("bogusfork") {
+		t.Error("want bogusfork to be rejected")
+	}
+}
+
+func TestSupportedHardforksIsChronological(t *testing.T) {
+	forks := SupportedHardforks()
+	if len(forks) == 0 {
+		t.Fatal("want at least one supported hardfork")
+	}
+	if forks[0] != "frontier" {
+		t.Errorf("got first fork %q, want frontier", forks[0])
+	}
+	if forks[len(forks)-1] != "cancun" {
+		t.Errorf("got last fork %q, want cancun", forks[len(forks)-1])
+	}
+}
+
+func TestHardforkNoteCoversEverySupportedFork(t *testing.T) {
+	for _, f := range SupportedHardforks() {
+		if HardforkNote(f) == "" {
+			t.Errorf("fork %q has no activation note", f)
+		}
+	}
+}
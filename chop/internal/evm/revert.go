@@ -0,0 +1,25 @@
+package evm
+
+import "encoding/binary"
+
+// revertSelector is the first 4 bytes of keccak256("Error(string)"),
+// the ABI-standard encoding Solidity's revert("reason") produces.
+var revertSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+
+// DecodeRevertReason extracts the human-readable string from output
+// produced by a Solidity `revert("reason")`, or "" if output doesn't
+// match the standard Error(string) ABI encoding.
+func DecodeRevertReason(output []byte) string {
+	if len(output) < 4+32+32 || [4]byte(output[:4]) != revertSelector {
+		return ""
+	}
+	// body is [offset(32) | length(32) | data], per the ABI encoding
+	// of a single dynamic string return value. The offset is always
+	// 32 for a lone return value, so skip straight to the length word.
+	body := output[4:]
+	length := binary.BigEndian.Uint64(body[56:64])
+	if length > uint64(len(body)-64) {
+		return ""
+	}
+	return string(body[64 : 64+length])
+}
@@ -0,0 +1,43 @@
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// TraceStep is a single step of a structured execution trace, mirroring
+// geth's struct logger output.
+type TraceStep struct {
+	PC           uint64 `json:"pc"`
+	Op           string `json:"op"`
+	GasRemaining uint64 `json:"gasRemaining"`
+	StackDepth   int    `json:"stackDepth"`
+}
+
+type traceOutput struct {
+	Result types.CallResult `json:"result"`
+	Trace  []TraceStep      `json:"trace"`
+}
+
+// ExecuteWithTrace runs ctx like ExecuteCall but additionally asks
+// guillotine to emit a step-by-step trace (PC, opcode, gas remaining,
+// stack depth) alongside the result.
+func (e *EVM) ExecuteWithTrace(ctx ExecutionContext) (types.CallResult, []TraceStep, error) {
+	baseArgs, err := e.baseArgs(ctx)
+	if err != nil {
+		return types.CallResult{}, nil, err
+	}
+	args := append(baseArgs, "--trace")
+	out, err := exec.Command(e.Binary, args...).Output()
+	if err != nil {
+		return types.CallResult{}, nil, fmt.Errorf("guillotine execution failed: %w", err)
+	}
+	var parsed traceOutput
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		return types.CallResult{}, nil, fmt.Errorf("parsing guillotine trace output: %w", err)
+	}
+	return parsed.Result.DecodeRevert(), parsed.Trace, nil
+}
@@ -0,0 +1,106 @@
+package evm
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// VMManager wraps an Executor with an optional persistent state
+// handle. With persistence off (the default, matching chop's existing
+// per-call behavior) every call starts from a blank slate. With
+// persistence on, each call's PostState is folded into a running
+// snapshot and fed back in as the next call's PreState, so a deploy's
+// storage is visible to a later call against the deployed contract.
+type VMManager struct {
+	executor   Executor
+	persistent bool
+	state      map[types.Address]types.AccountState
+}
+
+// NewVMManager returns a VMManager that executes calls via executor,
+// with persistence off.
+func NewVMManager(executor Executor) *VMManager {
+	return &VMManager{executor: executor, state: make(map[types.Address]types.AccountState)}
+}
+
+// SetPersistent turns state persistence on or off. Turning it off
+// leaves any already-accumulated state in place, just unused, so
+// re-enabling it later picks up where it left off; use ClearState to
+// discard it outright.
+func (m *VMManager) SetPersistent(on bool) {
+	m.persistent = on
+}
+
+// Persistent reports whether state persistence is currently enabled.
+func (m *VMManager) Persistent() bool {
+	return m.persistent
+}
+
+// ClearState discards any accumulated persistent state, so the next
+// call starts fresh even with persistence still enabled.
+func (m *VMManager) ClearState() {
+	m.state = make(map[types.Address]types.AccountState)
+}
+
+// ExecuteCall runs ctx. When persistence is enabled, it first overlays
+// the manager's accumulated state under ctx's own PreState (ctx's
+// entries win on conflict, so a caller can still force fresh state for
+// a specific address), then folds the call's reported PostState back
+// into that accumulated state for the next call.
+func (m *VMManager) ExecuteCall(ctx ExecutionContext) (types.CallResult, error) {
+	if m.persistent {
+		ctx.PreState = m.mergedPreState(ctx.PreState)
+		ctx.EmitPostState = true
+	}
+
+	result, err := m.executor.ExecuteCall(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if m.persistent {
+		for _, entry := range result.PostState {
+			m.state[entry.Address] = entry.Account
+		}
+	}
+	return result, nil
+}
+
+// ExecuteCallReadOnly runs ctx exactly like ExecuteCall - including
+// seeing the manager's accumulated persistent state as PreState - but
+// never folds its PostState back in, so it can't mutate balances,
+// nonces, or storage for a later call. Used where a caller needs a
+// dry-run against live state without side effects, e.g. eth_call or a
+// TUI preview.
+func (m *VMManager) ExecuteCallReadOnly(ctx ExecutionContext) (types.CallResult, error) {
+	if m.persistent {
+		ctx.PreState = m.mergedPreState(ctx.PreState)
+	}
+	return m.executor.ExecuteCall(ctx)
+}
+
+// mergedPreState overlays explicit onto m's accumulated state and
+// returns the result in a deterministic (address-sorted) order.
+func (m *VMManager) mergedPreState(explicit []types.PreStateEntry) []types.PreStateEntry {
+	merged := make(map[types.Address]types.AccountState, len(m.state)+len(explicit))
+	for addr, acct := range m.state {
+		merged[addr] = acct
+	}
+	for _, entry := range explicit {
+		merged[entry.Address] = entry.Account
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+
+	entries := make([]types.PreStateEntry, 0, len(merged))
+	for addr, acct := range merged {
+		entries = append(entries, types.PreStateEntry{Address: addr, Account: acct})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].Address[:], entries[j].Address[:]) < 0
+	})
+	return entries
+}
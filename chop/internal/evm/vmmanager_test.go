@@ -0,0 +1,166 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+var testSlot = types.Hash{0x01}
+
+// storageExecutor is a fake Executor that behaves like a minimal
+// single-slot key/value store: an Input of "write:<byte>" stores that
+// byte at testSlot for ctx.To and reports it via PostState; an Input
+// of "read" returns whatever testSlot currently holds in ctx.PreState
+// for ctx.To (zero if absent). It exists so VMManager's state-carrying
+// logic can be exercised without a real guillotine binary.
+type storageExecutor struct{}
+
+func (storageExecutor) ExecuteCall(ctx ExecutionContext) (types.CallResult, error) {
+	addr := *ctx.To
+
+	if len(ctx.Input) > 0 && ctx.Input[0] == 'w' {
+		value := types.Hash{ctx.Input[len(ctx.Input)-1]}
+		return types.CallResult{
+			Status: true,
+			PostState: []types.PreStateEntry{{
+				Address: addr,
+				Account: types.AccountState{Storage: []types.StorageSlot{{Key: testSlot, Value: value}}},
+			}},
+		}, nil
+	}
+
+	var current types.Hash
+	for _, entry := range ctx.PreState {
+		if entry.Address != addr {
+			continue
+		}
+		for _, slot := range entry.Account.Storage {
+			if slot.Key == testSlot {
+				current = slot.Value
+			}
+		}
+	}
+	return types.CallResult{Status: true, Output: current[:]}, nil
+}
+
+func TestVMManagerCarriesSStoreIntoLaterSLoad(t *testing.T) {
+	to := types.Address{0xaa}
+	manager := NewVMManager(storageExecutor{})
+	manager.SetPersistent(true)
+
+	if _, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("write:\x2a")}); err != nil {
+		t.Fatalf("write call: %v", err)
+	}
+
+	result, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("read")})
+	if err != nil {
+		t.Fatalf("read call: %v", err)
+	}
+	want := types.Hash{0x2a}
+	if types.Hash(result.Output[:32]) != want {
+		t.Errorf("got output %x, want %x", result.Output, want[:])
+	}
+}
+
+func TestVMManagerWithoutPersistenceDoesNotCarryState(t *testing.T) {
+	to := types.Address{0xaa}
+	manager := NewVMManager(storageExecutor{})
+
+	if _, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("write:\x2a")}); err != nil {
+		t.Fatalf("write call: %v", err)
+	}
+
+	result, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("read")})
+	if err != nil {
+		t.Fatalf("read call: %v", err)
+	}
+	var zero types.Hash
+	if types.Hash(result.Output[:32]) != zero {
+		t.Errorf("got output %x, want a zero slot since persistence is off", result.Output)
+	}
+}
+
+func TestVMManagerClearStateDiscardsAccumulatedState(t *testing.T) {
+	to := types.Address{0xaa}
+	manager := NewVMManager(storageExecutor{})
+	manager.SetPersistent(true)
+
+	if _, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("write:\x2a")}); err != nil {
+		t.Fatalf("write call: %v", err)
+	}
+	manager.ClearState()
+
+	result, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("read")})
+	if err != nil {
+		t.Fatalf("read call: %v", err)
+	}
+	var zero types.Hash
+	if types.Hash(result.Output[:32]) != zero {
+		t.Errorf("got output %x, want a zero slot after ClearState", result.Output)
+	}
+}
+
+func TestVMManagerExecuteCallReadOnlyDoesNotPersistWrites(t *testing.T) {
+	to := types.Address{0xaa}
+	manager := NewVMManager(storageExecutor{})
+	manager.SetPersistent(true)
+
+	if _, err := manager.ExecuteCallReadOnly(ExecutionContext{To: &to, Input: []byte("write:\x2a")}); err != nil {
+		t.Fatalf("read-only write call: %v", err)
+	}
+
+	result, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("read")})
+	if err != nil {
+		t.Fatalf("read call: %v", err)
+	}
+	var zero types.Hash
+	if types.Hash(result.Output[:32]) != zero {
+		t.Errorf("got output %x, want a zero slot since ExecuteCallReadOnly must not persist its SSTORE", result.Output)
+	}
+}
+
+func TestVMManagerExecuteCallReadOnlySeesAlreadyPersistedState(t *testing.T) {
+	to := types.Address{0xaa}
+	manager := NewVMManager(storageExecutor{})
+	manager.SetPersistent(true)
+
+	if _, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("write:\x2a")}); err != nil {
+		t.Fatalf("write call: %v", err)
+	}
+
+	result, err := manager.ExecuteCallReadOnly(ExecutionContext{To: &to, Input: []byte("read")})
+	if err != nil {
+		t.Fatalf("read-only read call: %v", err)
+	}
+	want := types.Hash{0x2a}
+	if types.Hash(result.Output[:32]) != want {
+		t.Errorf("got output %x, want %x (ExecuteCallReadOnly should still see prior committed state)", result.Output, want[:])
+	}
+}
+
+func TestVMManagerExplicitPreStateWinsOverPersistedState(t *testing.T) {
+	to := types.Address{0xaa}
+	manager := NewVMManager(storageExecutor{})
+	manager.SetPersistent(true)
+
+	if _, err := manager.ExecuteCall(ExecutionContext{To: &to, Input: []byte("write:\x2a")}); err != nil {
+		t.Fatalf("write call: %v", err)
+	}
+
+	override := types.Hash{0x99}
+	result, err := manager.ExecuteCall(ExecutionContext{
+		To:    &to,
+		Input: []byte("read"),
+		PreState: []types.PreStateEntry{{
+			Address: to,
+			Account: types.AccountState{Storage: []types.StorageSlot{{Key: testSlot, Value: override}}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("read call: %v", err)
+	}
+	if types.Hash(result.Output[:32]) != override {
+		t.Errorf("got output %x, want the explicit override %x", result.Output, override[:])
+	}
+}
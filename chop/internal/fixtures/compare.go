@@ -0,0 +1,24 @@
+package fixtures
+
+import (
+	"fmt"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// Compare checks result against fx's ExpectedResult, if any, mirroring
+// the TUI's gas accounting. A fixture with no ExpectedResult always
+// passes. diff is empty on a pass and describes the mismatch on a
+// fail.
+func Compare(fx Fixture, result types.CallResult) (pass bool, diff string) {
+	if fx.ExpectedResult == nil {
+		return true, ""
+	}
+	if result.Status == fx.ExpectedResult.Success && result.GasUsed == fx.ExpectedResult.GasUsed {
+		return true, ""
+	}
+	return false, fmt.Sprintf(
+		"success: got %v want %v; gasUsed: got %d want %d",
+		result.Status, fx.ExpectedResult.Success, result.GasUsed, fx.ExpectedResult.GasUsed,
+	)
+}
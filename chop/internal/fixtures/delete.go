@@ -0,0 +1,19 @@
+package fixtures
+
+import (
+	"fmt"
+	"os"
+)
+
+// Delete removes the fixture saved under name, returning a wrapped
+// error if it doesn't exist.
+func Delete(name string) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fixturePath(dir, name)); err != nil {
+		return fmt.Errorf("deleting fixture %q: %w", name, err)
+	}
+	return nil
+}
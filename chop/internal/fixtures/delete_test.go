@@ -0,0 +1,23 @@
+package fixtures
+
+import "testing"
+
+func TestDeleteExisting(t *testing.T) {
+	t.Setenv("CHOP_FIXTURES_DIR", t.TempDir())
+	if err := Save(Fixture{Name: "temp"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Delete("temp"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := Load("temp"); err == nil {
+		t.Error("want fixture gone after Delete")
+	}
+}
+
+func TestDeleteNonExistent(t *testing.T) {
+	t.Setenv("CHOP_FIXTURES_DIR", t.TempDir())
+	if err := Delete("does-not-exist"); err == nil {
+		t.Error("want error deleting a fixture that was never saved")
+	}
+}
@@ -0,0 +1,139 @@
+// Package fixtures saves and loads reusable call scenarios under
+// ~/.chop/fixtures so they can be re-run as ad hoc regression tests.
+package fixtures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// currentFixtureVersion is the Version written by Save and understood
+// by Load. Bump it whenever Fixture gains a field that needs migrating.
+const currentFixtureVersion = 1
+
+// ErrUnsupportedVersion is returned by Load when a fixture's Version
+// is newer than this binary understands.
+var ErrUnsupportedVersion = errors.New("fixture version is newer than this binary supports")
+
+// ExpectedResult is the expected outcome of executing a fixture,
+// checked on load so fixtures double as regression tests.
+type ExpectedResult struct {
+	Success bool   `json:"success"`
+	GasUsed uint64 `json:"gasUsed"`
+}
+
+// Fixture is a saved call scenario.
+type Fixture struct {
+	Version        int             `json:"version"`
+	Name           string          `json:"name"`
+	Bytecode       []byte          `json:"bytecode"`
+	Calldata       []byte          `json:"calldata"`
+	Caller         types.Address   `json:"caller"`
+	Value          uint64          `json:"value"`
+	GasLimit       uint64          `json:"gasLimit"`
+	ExpectedResult *ExpectedResult `json:"expectedResult,omitempty"`
+	// Block pins the block environment the fixture expects to run
+	// against. Older fixtures omit it and run under guillotine's
+	// defaults.
+	Block *types.BlockContext `json:"block,omitempty"`
+	// PreState seeds account balances/nonces/code/storage before the
+	// fixture's call executes. Older fixtures omit it.
+	PreState []types.PreStateEntry `json:"preState,omitempty"`
+}
+
+// migrate upgrades fx in place from its on-disk Version to
+// currentFixtureVersion, defaulting any fields introduced since.
+// Version 0 (no version field present in the JSON) predates
+// ExpectedResult's introduction but needs no data migration, since a
+// nil ExpectedResult is already its correct zero value.
+func migrate(fx Fixture) (Fixture, error) {
+	if fx.Version > currentFixtureVersion {
+		return Fixture{}, ErrUnsupportedVersion
+	}
+	fx.Version = currentFixtureVersion
+	return fx, nil
+}
+
+// Dir returns the directory fixtures are stored under, honoring
+// $CHOP_FIXTURES_DIR if set.
+func Dir() (string, error) {
+	if d := os.Getenv("CHOP_FIXTURES_DIR"); d != "" {
+		return d, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".chop", "fixtures"), nil
+}
+
+func fixturePath(dir, name string) string {
+	return filepath.Join(dir, name+".json")
+}
+
+// Save writes fx to disk under its Name, creating the fixtures
+// directory if needed.
+func Save(fx Fixture) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	fx.Version = currentFixtureVersion
+	data, err := json.MarshalIndent(fx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fixturePath(dir, fx.Name), data, 0o644)
+}
+
+// Load reads the fixture saved under name.
+func Load(name string) (Fixture, error) {
+	dir, err := Dir()
+	if err != nil {
+		return Fixture{}, err
+	}
+	data, err := os.ReadFile(fixturePath(dir, name))
+	if err != nil {
+		return Fixture{}, fmt.Errorf("loading fixture %q: %w", name, err)
+	}
+	var fx Fixture
+	if err := json.Unmarshal(data, &fx); err != nil {
+		return Fixture{}, err
+	}
+	return migrate(fx)
+}
+
+// List returns the names of every saved fixture, sorted
+// alphabetically, or an empty slice if the fixtures directory doesn't
+// exist yet.
+func List() ([]string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == ".json" {
+			names = append(names, strings.TrimSuffix(e.Name(), ".json"))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,126 @@
+package fixtures
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestSaveLoadListRoundtrip(t *testing.T) {
+	t.Setenv("CHOP_FIXTURES_DIR", t.TempDir())
+
+	fx := Fixture{Name: "basic-transfer", Bytecode: []byte{0x00}, GasLimit: 21000}
+	if err := Save(fx); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	names, err := List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(names) != 1 || names[0] != "basic-transfer" {
+		t.Fatalf("got %v", names)
+	}
+
+	got, err := Load("basic-transfer")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got.GasLimit != 21000 {
+		t.Errorf("got gas limit %d, want 21000", got.GasLimit)
+	}
+}
+
+func TestLoadMissingFixture(t *testing.T) {
+	t.Setenv("CHOP_FIXTURES_DIR", t.TempDir())
+	if _, err := Load("does-not-exist"); err == nil {
+		t.Error("want error for missing fixture")
+	}
+}
+
+func TestCompareNoExpectedAlwaysPasses(t *testing.T) {
+	pass, _ := Compare(Fixture{}, types.CallResult{Status: false})
+	if !pass {
+		t.Error("fixture with no ExpectedResult should always pass")
+	}
+}
+
+func TestLoadV0FixtureUpgradesCleanly(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("CHOP_FIXTURES_DIR", dir)
+
+	// A v0 fixture predates the version field entirely.
+	v0 := `{"name":"legacy","bytecode":"AA==","gasLimit":21000}`
+	if err := os.WriteFile(fixturePath(dir, "legacy"), []byte(v0), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fx, err := Load("legacy")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if fx.Version != currentFixtureVersion {
+		t.Errorf("got version %d, want %d", fx.Version, currentFixtureVersion)
+	}
+	if fx.GasLimit != 21000 {
+		t.Errorf("got gas limit %d, want 21000", fx.GasLimit)
+	}
+	if fx.ExpectedResult != nil {
+		t.Errorf("want nil ExpectedResult on an upgraded v0 fixture, got %+v", fx.ExpectedResult)
+	}
+}
+
+func TestSaveLoadFixtureWithNonDefaultBaseFee(t *testing.T) {
+	t.Setenv("CHOP_FIXTURES_DIR", t.TempDir())
+
+	fx := Fixture{
+		Name:     "custom-base-fee",
+		GasLimit: 21000,
+		Block:    &types.BlockContext{ChainID: 1, BlockNumber: 100, BaseFee: 42_000_000_000},
+	}
+	if err := Save(fx); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := Load("custom-base-fee")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got.Block == nil {
+		t.Fatal("want Block to round-trip, got nil")
+	}
+	if got.Block.BaseFee != 42_000_000_000 {
+		t.Errorf("got base fee %d, want 42000000000", got.Block.BaseFee)
+	}
+}
+
+func TestLoadFutureVersionReturnsErrUnsupportedVersion(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("CHOP_FIXTURES_DIR", dir)
+
+	future := `{"version":999,"name":"too-new"}`
+	if err := os.WriteFile(fixturePath(dir, "too-new"), []byte(future), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Load("too-new")
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("got err %v, want ErrUnsupportedVersion", err)
+	}
+}
+
+func TestCompareMatchAndMismatch(t *testing.T) {
+	fx := Fixture{ExpectedResult: &ExpectedResult{Success: true, GasUsed: 21000}}
+
+	pass, diff := Compare(fx, types.CallResult{Status: true, GasUsed: 21000})
+	if !pass || diff != "" {
+		t.Errorf("want pass with no diff, got pass=%v diff=%q", pass, diff)
+	}
+
+	pass, diff = Compare(fx, types.CallResult{Status: true, GasUsed: 22000})
+	if pass || diff == "" {
+		t.Errorf("want mismatch with a diff, got pass=%v diff=%q", pass, diff)
+	}
+}
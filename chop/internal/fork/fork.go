@@ -0,0 +1,229 @@
+// Package fork lets the EVM read account state from a live chain via
+// its JSON-RPC endpoint, so a chop session can fork a real network
+// instead of starting from an empty state.
+package fork
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// defaultCacheSize is used when Config.CacheSize is zero.
+const defaultCacheSize = 1024
+
+// Config configures a Forker.
+type Config struct {
+	// URL is the JSON-RPC endpoint to fork from.
+	URL string
+	// BlockNumber pins the block to read state at. Zero means the
+	// remote's "latest" block.
+	BlockNumber uint64
+	// CacheSize is the maximum number of accounts (and, separately,
+	// storage slots) to cache. Zero uses defaultCacheSize.
+	CacheSize int
+}
+
+// storageKey identifies one cached storage slot.
+type storageKey struct {
+	addr types.Address
+	slot types.Hash
+}
+
+// Forker lazily fetches and caches account state from a remote RPC
+// endpoint. The EVM calls GetAccount/GetStorage on a local state miss.
+type Forker struct {
+	cfg        Config
+	blockParam string
+	client     *http.Client
+
+	mu           sync.Mutex
+	accountCache *lru
+	storageCache *lru
+	hits         int
+	misses       int
+}
+
+// Stats summarizes a Forker's cache behavior since it was created.
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+	Entries   int
+}
+
+// Stats returns the Forker's current cache hit/miss/eviction counts
+// and how many entries (accounts plus storage slots) are cached now.
+func (f *Forker) Stats() Stats {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return Stats{
+		Hits:      f.hits,
+		Misses:    f.misses,
+		Evictions: f.accountCache.evictions + f.storageCache.evictions,
+		Entries:   f.accountCache.len() + f.storageCache.len(),
+	}
+}
+
+// ClearCache empties both the account and storage caches, dropping
+// their entry counts to zero. Cumulative hit/miss/eviction totals in
+// Stats are preserved.
+func (f *Forker) ClearCache() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.accountCache.clear()
+	f.storageCache.clear()
+}
+
+// NewForker returns a Forker reading state from cfg.URL as of
+// cfg.BlockNumber (or the remote's latest block, if zero).
+func NewForker(cfg Config) (*Forker, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("fork: Config.URL is required")
+	}
+	cacheSize := cfg.CacheSize
+	if cacheSize == 0 {
+		cacheSize = defaultCacheSize
+	}
+	blockParam := "latest"
+	if cfg.BlockNumber != 0 {
+		blockParam = "0x" + strconv.FormatUint(cfg.BlockNumber, 16)
+	}
+	return &Forker{
+		cfg:          cfg,
+		blockParam:   blockParam,
+		client:       http.DefaultClient,
+		accountCache: newLRU(cacheSize),
+		storageCache: newLRU(cacheSize),
+	}, nil
+}
+
+// GetAccount returns addr's balance, nonce, and code as of the
+// Forker's pinned block, fetching from the remote RPC on a cache miss.
+func (f *Forker) GetAccount(addr types.Address) (types.AccountState, error) {
+	f.mu.Lock()
+	if cached, ok := f.accountCache.get(addr); ok {
+		f.hits++
+		f.mu.Unlock()
+		return cached.(types.AccountState), nil
+	}
+	f.misses++
+	f.mu.Unlock()
+
+	balance, err := f.callQuantity("eth_getBalance", hexAddr(addr))
+	if err != nil {
+		return types.AccountState{}, err
+	}
+	nonce, err := f.callQuantity("eth_getTransactionCount", hexAddr(addr))
+	if err != nil {
+		return types.AccountState{}, err
+	}
+	code, err := f.callBytes("eth_getCode", hexAddr(addr))
+	if err != nil {
+		return types.AccountState{}, err
+	}
+
+	account := types.AccountState{Balance: balance, Nonce: nonce, Code: code}
+	f.mu.Lock()
+	f.accountCache.put(addr, account)
+	f.mu.Unlock()
+	return account, nil
+}
+
+// GetStorage returns the value at addr's storage slot as of the
+// Forker's pinned block, fetching from the remote RPC on a cache miss.
+func (f *Forker) GetStorage(addr types.Address, slot types.Hash) (types.Hash, error) {
+	key := storageKey{addr: addr, slot: slot}
+	f.mu.Lock()
+	if cached, ok := f.storageCache.get(key); ok {
+		f.hits++
+		f.mu.Unlock()
+		return cached.(types.Hash), nil
+	}
+	f.misses++
+	f.mu.Unlock()
+
+	data, err := f.callBytes("eth_getStorageAt", hexAddr(addr), "0x"+hex.EncodeToString(slot[:]))
+	if err != nil {
+		return types.Hash{}, err
+	}
+	var value types.Hash
+	// eth_getStorageAt pads to 32 bytes; right-align a shorter reply.
+	copy(value[32-len(data):], data)
+
+	f.mu.Lock()
+	f.storageCache.put(key, value)
+	f.mu.Unlock()
+	return value, nil
+}
+
+func hexAddr(addr types.Address) string {
+	return "0x" + hex.EncodeToString(addr[:])
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	Result string `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// call issues a JSON-RPC request to the remote endpoint with the
+// Forker's pinned block appended as the final param, and returns the
+// hex-encoded result string.
+func (f *Forker) call(method string, params ...string) (string, error) {
+	allParams := make([]any, 0, len(params)+1)
+	for _, p := range params {
+		allParams = append(allParams, p)
+	}
+	allParams = append(allParams, f.blockParam)
+
+	body, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: allParams})
+	if err != nil {
+		return "", err
+	}
+	resp, err := f.client.Post(f.cfg.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("fork: %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	var parsed rpcResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("fork: %s: decoding response: %w", method, err)
+	}
+	if parsed.Error != nil {
+		return "", fmt.Errorf("fork: %s: %s", method, parsed.Error.Message)
+	}
+	return parsed.Result, nil
+}
+
+func (f *Forker) callQuantity(method string, params ...string) (uint64, error) {
+	result, err := f.call(method, params...)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(result, "0x"), 16, 64)
+}
+
+func (f *Forker) callBytes(method string, params ...string) ([]byte, error) {
+	result, err := f.call(method, params...)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimPrefix(result, "0x"))
+}
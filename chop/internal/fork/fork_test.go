@@ -0,0 +1,175 @@
+package fork
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// newMockRPC returns a server answering eth_getBalance/eth_getCode/
+// eth_getTransactionCount/eth_getStorageAt with fixed canned values,
+// and a counter of how many requests it received per method.
+func newMockRPC(t *testing.T) (*httptest.Server, map[string]int) {
+	t.Helper()
+	calls := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		calls[req.Method]++
+
+		var result string
+		switch req.Method {
+		case "eth_getBalance":
+			result = "0x64" // 100
+		case "eth_getTransactionCount":
+			result = "0x5" // 5
+		case "eth_getCode":
+			result = "0x6001"
+		case "eth_getStorageAt":
+			result = "0x" + "00000000000000000000000000000000000000000000000000000000000042"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, calls
+}
+
+func TestGetAccountFetchesAndCaches(t *testing.T) {
+	srv, calls := newMockRPC(t)
+	f, err := NewForker(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := types.Address{0x01}
+	account, err := f.GetAccount(addr)
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if account.Balance != 100 || account.Nonce != 5 {
+		t.Errorf("got account %+v, want balance=100 nonce=5", account)
+	}
+
+	if _, err := f.GetAccount(addr); err != nil {
+		t.Fatalf("GetAccount (cached): %v", err)
+	}
+	if calls["eth_getBalance"] != 1 {
+		t.Errorf("got %d eth_getBalance calls, want 1 (second GetAccount should hit cache)", calls["eth_getBalance"])
+	}
+}
+
+func TestGetStorageFetchesAndCaches(t *testing.T) {
+	srv, calls := newMockRPC(t)
+	f, err := NewForker(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := types.Address{0x01}
+	slot := types.Hash{0x01}
+	value, err := f.GetStorage(addr, slot)
+	if err != nil {
+		t.Fatalf("GetStorage: %v", err)
+	}
+	if value[31] != 0x42 {
+		t.Errorf("got storage value %x, want last byte 0x42", value)
+	}
+
+	if _, err := f.GetStorage(addr, slot); err != nil {
+		t.Fatalf("GetStorage (cached): %v", err)
+	}
+	if calls["eth_getStorageAt"] != 1 {
+		t.Errorf("got %d eth_getStorageAt calls, want 1 (second GetStorage should hit cache)", calls["eth_getStorageAt"])
+	}
+}
+
+func TestBlockNumberZeroUsesLatest(t *testing.T) {
+	f, err := NewForker(Config{URL: "http://example.invalid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.blockParam != "latest" {
+		t.Errorf("got block param %q, want latest", f.blockParam)
+	}
+}
+
+func TestNewForkerRequiresURL(t *testing.T) {
+	if _, err := NewForker(Config{}); err == nil {
+		t.Error("want an error when Config.URL is empty")
+	}
+}
+
+func TestStatsTracksHitsAndMisses(t *testing.T) {
+	srv, _ := newMockRPC(t)
+	f, err := NewForker(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := types.Address{0x01}
+	if _, err := f.GetAccount(addr); err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if _, err := f.GetAccount(addr); err != nil {
+		t.Fatalf("GetAccount (cached): %v", err)
+	}
+
+	stats := f.Stats()
+	if stats.Misses != 1 || stats.Hits != 1 {
+		t.Errorf("got stats %+v, want 1 miss and 1 hit", stats)
+	}
+	if stats.Entries != 1 {
+		t.Errorf("got %d entries, want 1", stats.Entries)
+	}
+}
+
+func TestStatsCountsEvictionsSeparatelyFromMisses(t *testing.T) {
+	srv, _ := newMockRPC(t)
+	f, err := NewForker(Config{URL: srv.URL, CacheSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.GetAccount(types.Address{0x01}); err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if _, err := f.GetAccount(types.Address{0x02}); err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+
+	stats := f.Stats()
+	if stats.Misses != 2 {
+		t.Errorf("got %d misses, want 2", stats.Misses)
+	}
+	if stats.Evictions != 1 {
+		t.Errorf("got %d evictions, want 1 (cache size 1 forces eviction on second account)", stats.Evictions)
+	}
+}
+
+func TestClearCacheResetsEntriesButPreservesTotals(t *testing.T) {
+	srv, _ := newMockRPC(t)
+	f, err := NewForker(Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.GetAccount(types.Address{0x01}); err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+
+	f.ClearCache()
+
+	stats := f.Stats()
+	if stats.Entries != 0 {
+		t.Errorf("got %d entries after ClearCache, want 0", stats.Entries)
+	}
+	if stats.Misses != 1 {
+		t.Errorf("got %d misses after ClearCache, want the prior total of 1 preserved", stats.Misses)
+	}
+}
@@ -0,0 +1,73 @@
+package fork
+
+import "container/list"
+
+// lru is a fixed-capacity least-recently-used cache keyed by any
+// comparable key. It is not safe for concurrent use; callers guard it
+// with their own lock.
+type lru struct {
+	capacity  int
+	entries   map[any]*list.Element
+	order     *list.List // front = most recently used
+	evictions int
+}
+
+type lruEntry struct {
+	key   any
+	value any
+}
+
+func newLRU(capacity int) *lru {
+	return &lru{
+		capacity: capacity,
+		entries:  make(map[any]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// get returns the cached value for key, promoting it to
+// most-recently-used.
+func (c *lru) get(key any) (any, bool) {
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).value, true
+}
+
+// put inserts or updates key's value, evicting the least-recently-used
+// entry if the cache is now over capacity.
+func (c *lru) put(key, value any) {
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruEntry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+	el := c.order.PushFront(&lruEntry{key: key, value: value})
+	c.entries[key] = el
+	if c.order.Len() > c.capacity {
+		c.evictOldest()
+	}
+}
+
+func (c *lru) evictOldest() {
+	oldest := c.order.Back()
+	if oldest == nil {
+		return
+	}
+	c.order.Remove(oldest)
+	delete(c.entries, oldest.Value.(*lruEntry).key)
+	c.evictions++
+}
+
+// len returns the number of entries currently cached.
+func (c *lru) len() int {
+	return c.order.Len()
+}
+
+// clear empties the cache.
+func (c *lru) clear() {
+	c.entries = make(map[any]*list.Element)
+	c.order.Init()
+}
@@ -0,0 +1,310 @@
+// Package genesis parses anvil/hardhat-style genesis JSON files
+// ("alloc" maps of address to balance/nonce/code/storage) and applies
+// the accounts and contract state they describe to a fresh chop
+// session.
+package genesis
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/types"
+)
+
+// Account is one address's state as declared in a genesis file, after
+// validation and decoding.
+type Account struct {
+	Address types.Address
+	Balance *big.Int
+	Nonce   uint64
+	Code    []byte
+	Storage []types.StorageSlot
+}
+
+// rawAccount mirrors anvil/hardhat's `alloc` entry shape: balance and
+// nonce as 0x-prefixed hex strings, code as 0x-prefixed hex, storage as
+// a map of 0x-prefixed hex slot to 0x-prefixed hex value.
+type rawAccount struct {
+	Balance string            `json:"balance"`
+	Nonce   string            `json:"nonce"`
+	Code    string            `json:"code"`
+	Storage map[string]string `json:"storage"`
+}
+
+type rawGenesis struct {
+	Alloc map[string]rawAccount `json:"alloc"`
+}
+
+// Load parses the genesis JSON file at path into a list of decoded
+// accounts, sorted by address for a stable, reproducible order. It
+// validates every address and hex field up front, failing on the
+// first malformed entry it finds rather than applying a partially
+// broken file.
+func Load(path string) ([]Account, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("genesis: %w", err)
+	}
+	var raw rawGenesis
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("genesis: parsing %s: %w", path, err)
+	}
+
+	names := make([]string, 0, len(raw.Alloc))
+	for addrHex := range raw.Alloc {
+		names = append(names, addrHex)
+	}
+	sort.Strings(names)
+
+	accountsOut := make([]Account, 0, len(names))
+	for _, addrHex := range names {
+		ra := raw.Alloc[addrHex]
+		addr, err := parseAddress(addrHex)
+		if err != nil {
+			return nil, fmt.Errorf("genesis: account %q: %w", addrHex, err)
+		}
+		balance, err := parseBalance(ra.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("genesis: account %s: balance: %w", addrHex, err)
+		}
+		nonce, err := parseUint64(ra.Nonce)
+		if err != nil {
+			return nil, fmt.Errorf("genesis: account %s: nonce: %w", addrHex, err)
+		}
+		code, err := parseHexBytes(ra.Code)
+		if err != nil {
+			return nil, fmt.Errorf("genesis: account %s: code: %w", addrHex, err)
+		}
+		storage, err := parseStorage(ra.Storage)
+		if err != nil {
+			return nil, fmt.Errorf("genesis: account %s: %w", addrHex, err)
+		}
+		accountsOut = append(accountsOut, Account{
+			Address: addr,
+			Balance: balance,
+			Nonce:   nonce,
+			Code:    code,
+			Storage: storage,
+		})
+	}
+	return accountsOut, nil
+}
+
+// PreStateEntry converts a into a types.PreStateEntry for use as
+// evm.ExecutionContext.PreState, e.g. when seeding a single `chop
+// call` rather than a long-lived `chop serve` session. Returns an
+// error if a's Balance doesn't fit in the uint64 that
+// types.AccountState represents balances with.
+func (a Account) PreStateEntry() (types.PreStateEntry, error) {
+	if !a.Balance.IsUint64() {
+		return types.PreStateEntry{}, fmt.Errorf("genesis: account %x: balance %s overflows uint64 pre-state balances", a.Address, a.Balance)
+	}
+	return types.PreStateEntry{
+		Address: a.Address,
+		Account: types.AccountState{
+			Balance: a.Balance.Uint64(),
+			Nonce:   a.Nonce,
+			Code:    a.Code,
+			Storage: a.Storage,
+		},
+	}, nil
+}
+
+// ApplyToChain seeds chain with accountsList: balance and nonce go
+// through chain.Accounts, code and storage go through chain.SetCode
+// and chain.SetStorage directly, matching how `chop serve` reads this
+// state back out at execution time.
+func ApplyToChain(chain *blockchain.Chain, accountsList []Account) {
+	for _, a := range accountsList {
+		chain.Accounts.SetBalance(a.Address, a.Balance)
+		chain.Accounts.SetNonce(a.Address, a.Nonce)
+		if len(a.Code) > 0 {
+			chain.SetCode(a.Address, a.Code)
+		}
+		for _, slot := range a.Storage {
+			chain.SetStorage(a.Address, slot.Key, slot.Value)
+		}
+	}
+}
+
+// Dump gathers every account chain knows about - everything its
+// accounts.Manager has plus every address with contract code - into
+// an Account list sorted by address, the inverse of ApplyToChain.
+// Storage is only collected when includeStorage is true, since a full
+// storage dump can be large.
+func Dump(chain *blockchain.Chain, includeStorage bool) []Account {
+	seen := make(map[types.Address]bool)
+	for _, entry := range chain.Accounts.GetAllAccounts() {
+		seen[entry.Address] = true
+	}
+	for _, addr := range chain.CodeAddresses() {
+		seen[addr] = true
+	}
+
+	addrs := make([]types.Address, 0, len(seen))
+	for addr := range seen {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+
+	accountsOut := make([]Account, 0, len(addrs))
+	for _, addr := range addrs {
+		acc := chain.Accounts.GetAccount(addr)
+		a := Account{
+			Address: addr,
+			Balance: acc.Balance,
+			Nonce:   acc.Nonce,
+			Code:    chain.CodeAt(addr),
+		}
+		if includeStorage {
+			slots := chain.AllStorage(addr)
+			keys := make([]types.Hash, 0, len(slots))
+			for k := range slots {
+				keys = append(keys, k)
+			}
+			sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+			for _, k := range keys {
+				a.Storage = append(a.Storage, types.StorageSlot{Key: k, Value: slots[k]})
+			}
+		}
+		accountsOut = append(accountsOut, a)
+	}
+	return accountsOut
+}
+
+// Save writes accountsList to path as genesis-shaped JSON, the same
+// format Load reads.
+func Save(path string, accountsList []Account) error {
+	raw := rawGenesis{Alloc: make(map[string]rawAccount, len(accountsList))}
+	for _, a := range accountsList {
+		ra := rawAccount{
+			Balance: "0x" + a.Balance.Text(16),
+			Nonce:   fmt.Sprintf("0x%x", a.Nonce),
+		}
+		if len(a.Code) > 0 {
+			ra.Code = "0x" + hex.EncodeToString(a.Code)
+		}
+		if len(a.Storage) > 0 {
+			ra.Storage = make(map[string]string, len(a.Storage))
+			for _, slot := range a.Storage {
+				ra.Storage["0x"+hex.EncodeToString(slot.Key[:])] = "0x" + hex.EncodeToString(slot.Value[:])
+			}
+		}
+		raw.Alloc[fmt.Sprintf("0x%x", a.Address)] = ra
+	}
+
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return fmt.Errorf("genesis: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("genesis: writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// DumpState gathers chain's state via Dump and writes it to path via
+// Save, for snapshotting a `chop serve` session so it can be reloaded
+// later with --genesis.
+func DumpState(chain *blockchain.Chain, path string, includeStorage bool) error {
+	return Save(path, Dump(chain, includeStorage))
+}
+
+func parseAddress(s string) (types.Address, error) {
+	var addr types.Address
+	b, err := parseHexBytes(s)
+	if err != nil {
+		return addr, err
+	}
+	if len(b) != len(addr) {
+		return addr, fmt.Errorf("want %d address bytes, got %d", len(addr), len(b))
+	}
+	copy(addr[:], b)
+	return addr, nil
+}
+
+func parseHexBytes(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	s = strings.TrimPrefix(s, "0x")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex %q: %w", s, err)
+	}
+	return b, nil
+}
+
+func parseHash(s string) (types.Hash, error) {
+	var h types.Hash
+	b, err := parseHexBytes(s)
+	if err != nil {
+		return h, err
+	}
+	if len(b) > len(h) {
+		return h, fmt.Errorf("%q is longer than 32 bytes", s)
+	}
+	copy(h[len(h)-len(b):], b)
+	return h, nil
+}
+
+func parseBalance(s string) (*big.Int, error) {
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	s = strings.TrimPrefix(s, "0x")
+	balance, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex %q", s)
+	}
+	if balance.Sign() < 0 {
+		return nil, fmt.Errorf("balance %q is negative", s)
+	}
+	return balance, nil
+}
+
+func parseUint64(s string) (uint64, error) {
+	b, err := parseBalance(s)
+	if err != nil {
+		return 0, err
+	}
+	if !b.IsUint64() {
+		return 0, fmt.Errorf("%q overflows uint64", s)
+	}
+	return b.Uint64(), nil
+}
+
+func parseStorage(raw map[string]string) ([]types.StorageSlot, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	slots := make([]types.StorageSlot, 0, len(keys))
+	for _, k := range keys {
+		key, err := parseHash(k)
+		if err != nil {
+			return nil, fmt.Errorf("storage key %q: %w", k, err)
+		}
+		value, err := parseHash(raw[k])
+		if err != nil {
+			return nil, fmt.Errorf("storage value for key %q: %w", k, err)
+		}
+		slots = append(slots, types.StorageSlot{Key: key, Value: value})
+	}
+	return slots, nil
+}
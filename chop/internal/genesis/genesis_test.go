@@ -0,0 +1,194 @@
+package genesis
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/types"
+)
+
+func writeGenesis(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing genesis fixture: %v", err)
+	}
+	return path
+}
+
+func TestLoadParsesPreDeployedContract(t *testing.T) {
+	path := writeGenesis(t, `{
+		"alloc": {
+			"0x0000000000000000000000000000000000000042": {
+				"balance": "0x1000",
+				"nonce": "0x2",
+				"code": "0x6001600155",
+				"storage": {
+					"0x0000000000000000000000000000000000000000000000000000000000000001": "0x000000000000000000000000000000000000000000000000000000000000002a"
+				}
+			}
+		}
+	}`)
+
+	accountsList, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(accountsList) != 1 {
+		t.Fatalf("got %d accounts, want 1", len(accountsList))
+	}
+
+	a := accountsList[0]
+	wantAddr := types.Address{0x42}
+	if a.Address != wantAddr {
+		t.Errorf("got address %x, want %x", a.Address, wantAddr)
+	}
+	if a.Balance.Uint64() != 0x1000 {
+		t.Errorf("got balance %s, want 0x1000", a.Balance)
+	}
+	if a.Nonce != 2 {
+		t.Errorf("got nonce %d, want 2", a.Nonce)
+	}
+	if string(a.Code) != "\x60\x01\x60\x01\x55" {
+		t.Errorf("got code %x, want 6001600155", a.Code)
+	}
+	if len(a.Storage) != 1 || a.Storage[0].Value[31] != 0x2a {
+		t.Errorf("got storage %+v, want slot 1 => 42", a.Storage)
+	}
+}
+
+func TestApplyToChainSeedsCodeAndStorage(t *testing.T) {
+	path := writeGenesis(t, `{
+		"alloc": {
+			"0x0000000000000000000000000000000000000042": {
+				"balance": "0x64",
+				"code": "0x600a",
+				"storage": {
+					"0x01": "0x2a"
+				}
+			}
+		}
+	}`)
+	accountsList, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	chain := blockchain.New()
+	ApplyToChain(chain, accountsList)
+
+	addr := types.Address{0x42}
+	if got := chain.Accounts.GetAccount(addr).Balance.Uint64(); got != 0x64 {
+		t.Errorf("got balance %d, want 0x64", got)
+	}
+	if got := chain.CodeAt(addr); string(got) != "\x60\x0a" {
+		t.Errorf("got code %x, want 600a", got)
+	}
+	var slot types.Hash
+	slot[31] = 0x01
+	if got := chain.StorageAt(addr, slot); got[31] != 0x2a {
+		t.Errorf("got storage slot 1 = %x, want 2a", got)
+	}
+}
+
+func TestPreStateEntryConvertsAccount(t *testing.T) {
+	a := Account{
+		Address: types.Address{0x42},
+		Balance: big.NewInt(100),
+		Nonce:   3,
+		Code:    []byte{0x60, 0x0a},
+	}
+	entry, err := a.PreStateEntry()
+	if err != nil {
+		t.Fatalf("PreStateEntry: %v", err)
+	}
+	if entry.Address != a.Address || entry.Account.Balance != 100 || entry.Account.Nonce != 3 {
+		t.Errorf("got %+v, want balance 100 nonce 3", entry)
+	}
+}
+
+func TestDumpStateRoundTrips(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	bob := types.Address{0x02}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+	chain.Accounts.SetNonce(alice, 5)
+	chain.Accounts.SetBalance(bob, big.NewInt(2000))
+	chain.SetCode(bob, []byte{0x60, 0x0a})
+	var slot, value types.Hash
+	slot[31] = 0x01
+	value[31] = 0x2a
+	chain.SetStorage(bob, slot, value)
+
+	path := filepath.Join(t.TempDir(), "dump.json")
+	if err := DumpState(chain, path, true); err != nil {
+		t.Fatalf("DumpState: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	fresh := blockchain.New()
+	ApplyToChain(fresh, reloaded)
+
+	if got := fresh.Accounts.GetAccount(alice); got.Balance.Uint64() != 1000 || got.Nonce != 5 {
+		t.Errorf("got alice %+v, want balance 1000 nonce 5", got)
+	}
+	if got := fresh.Accounts.GetAccount(bob).Balance.Uint64(); got != 2000 {
+		t.Errorf("got bob balance %d, want 2000", got)
+	}
+	if got := fresh.CodeAt(bob); string(got) != "\x60\x0a" {
+		t.Errorf("got bob code %x, want 600a", got)
+	}
+	if got := fresh.StorageAt(bob, slot); got != value {
+		t.Errorf("got bob storage slot 1 = %x, want %x", got, value)
+	}
+}
+
+func TestDumpStateOmitsStorageWhenNotRequested(t *testing.T) {
+	chain := blockchain.New()
+	addr := types.Address{0x01}
+	chain.Accounts.SetBalance(addr, big.NewInt(1))
+	var slot, value types.Hash
+	slot[31] = 0x01
+	value[31] = 0x2a
+	chain.SetStorage(addr, slot, value)
+
+	path := filepath.Join(t.TempDir(), "dump.json")
+	if err := DumpState(chain, path, false); err != nil {
+		t.Fatalf("DumpState: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(reloaded) != 1 || len(reloaded[0].Storage) != 0 {
+		t.Errorf("got %+v, want storage omitted", reloaded)
+	}
+}
+
+func TestLoadRejectsMalformedEntry(t *testing.T) {
+	path := writeGenesis(t, `{
+		"alloc": {
+			"not-an-address": {"balance": "0x1"}
+		}
+	}`)
+	if _, err := Load(path); err == nil {
+		t.Error("want an error for a malformed address")
+	}
+
+	path = writeGenesis(t, `{
+		"alloc": {
+			"0x0000000000000000000000000000000000000042": {"balance": "not-hex"}
+		}
+	}`)
+	if _, err := Load(path); err == nil {
+		t.Error("want an error for a malformed balance")
+	}
+}
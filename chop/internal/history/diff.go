@@ -0,0 +1,27 @@
+package history
+
+import "fmt"
+
+// DiffField is one attribute compared between two Entries by Diff,
+// pre-formatted for display.
+type DiffField struct {
+	Name    string
+	A, B    string
+	Differs bool
+}
+
+// Diff compares two Entries' gas used, status, return data, and logs,
+// returning one DiffField per attribute in that order, each flagged
+// with whether a and b differ on it.
+func Diff(a, b Entry) []DiffField {
+	fields := []DiffField{
+		{Name: "gasUsed", A: fmt.Sprintf("%d", a.Call.GasUsed), B: fmt.Sprintf("%d", b.Call.GasUsed)},
+		{Name: "status", A: fmt.Sprintf("%v", a.Call.Status), B: fmt.Sprintf("%v", b.Call.Status)},
+		{Name: "output", A: fmt.Sprintf("0x%x", a.Call.Output), B: fmt.Sprintf("0x%x", b.Call.Output)},
+		{Name: "logs", A: fmt.Sprintf("%d log(s)", len(a.Call.Logs)), B: fmt.Sprintf("%d log(s)", len(b.Call.Logs))},
+	}
+	for i := range fields {
+		fields[i].Differs = fields[i].A != fields[i].B
+	}
+	return fields
+}
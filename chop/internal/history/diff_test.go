@@ -0,0 +1,63 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestDiffFlagsDifferingGasUsedAndStatus(t *testing.T) {
+	a := Entry{Call: types.CallResult{GasUsed: 21000, Status: true}}
+	b := Entry{Call: types.CallResult{GasUsed: 23000, Status: false}}
+
+	fields := Diff(a, b)
+
+	var gasUsed, status DiffField
+	for _, f := range fields {
+		switch f.Name {
+		case "gasUsed":
+			gasUsed = f
+		case "status":
+			status = f
+		}
+	}
+	if !gasUsed.Differs || gasUsed.A != "21000" || gasUsed.B != "23000" {
+		t.Errorf("got gasUsed field %+v, want differing 21000/23000", gasUsed)
+	}
+	if !status.Differs || status.A != "true" || status.B != "false" {
+		t.Errorf("got status field %+v, want differing true/false", status)
+	}
+}
+
+func TestDiffMatchesIdenticalEntries(t *testing.T) {
+	call := types.CallResult{GasUsed: 21000, Status: true, Output: []byte{0xaa}}
+	a := Entry{Call: call}
+	b := Entry{Call: call}
+
+	for _, f := range Diff(a, b) {
+		if f.Differs {
+			t.Errorf("got field %q differing for identical entries, want no differences", f.Name)
+		}
+	}
+}
+
+func TestDiffFlagsDifferingOutputAndLogCount(t *testing.T) {
+	a := Entry{Call: types.CallResult{Output: []byte{0x01}, Logs: nil}}
+	b := Entry{Call: types.CallResult{Output: []byte{0x02}, Logs: []types.Log{{}}}}
+
+	var output, logs DiffField
+	for _, f := range Diff(a, b) {
+		switch f.Name {
+		case "output":
+			output = f
+		case "logs":
+			logs = f
+		}
+	}
+	if !output.Differs {
+		t.Error("want output to differ")
+	}
+	if !logs.Differs || logs.A != "0 log(s)" || logs.B != "1 log(s)" {
+		t.Errorf("got logs field %+v, want differing 0 log(s)/1 log(s)", logs)
+	}
+}
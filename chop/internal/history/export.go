@@ -0,0 +1,105 @@
+package history
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// exportRow is a single flattened Entry, shared by both Export
+// formats: timestamp, call type (call or create), caller, target,
+// value, gas used, and success.
+type exportRow struct {
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"`
+	Caller    string `json:"caller"`
+	Target    string `json:"target"`
+	Value     uint64 `json:"value"`
+	GasUsed   uint64 `json:"gasUsed"`
+	Success   bool   `json:"success"`
+}
+
+var exportColumns = []string{"timestamp", "type", "caller", "target", "value", "gasUsed", "success"}
+
+// Export writes every entry in s to path, in the given format ("csv"
+// or "json"). An unrecognized format is an error; the file is
+// truncated and recreated if it already exists.
+func (s *Store) Export(format, path string) error {
+	rows := toExportRows(s.All())
+
+	var data []byte
+	var err error
+	switch format {
+	case "csv":
+		data, err = encodeExportCSV(rows)
+	case "json":
+		data, err = json.MarshalIndent(rows, "", "  ")
+	default:
+		return fmt.Errorf("history: unsupported export format %q (want csv or json)", format)
+	}
+	if err != nil {
+		return fmt.Errorf("history: encoding export: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("history: writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// toExportRows flattens entries into exportRows, oldest first.
+func toExportRows(entries []Entry) []exportRow {
+	rows := make([]exportRow, len(entries))
+	for i, e := range entries {
+		callType := "call"
+		target := ""
+		if e.Call.DeployedAddress != nil {
+			callType = "create"
+		}
+		if e.Call.To != nil {
+			target = fmt.Sprintf("0x%x", *e.Call.To)
+		}
+		rows[i] = exportRow{
+			Timestamp: e.Timestamp.Format(time.RFC3339),
+			Type:      callType,
+			Caller:    fmt.Sprintf("0x%x", e.Call.From),
+			Target:    target,
+			Value:     e.Call.Value,
+			GasUsed:   e.Call.GasUsed,
+			Success:   e.Call.Status,
+		}
+	}
+	return rows
+}
+
+// encodeExportCSV renders rows as CSV with a header row.
+func encodeExportCSV(rows []exportRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportColumns); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		record := []string{
+			row.Timestamp,
+			row.Type,
+			row.Caller,
+			row.Target,
+			strconv.FormatUint(row.Value, 10),
+			strconv.FormatUint(row.GasUsed, 10),
+			strconv.FormatBool(row.Success),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestExportCSVWritesAllEntries(t *testing.T) {
+	s := NewStore()
+	to := types.Address{0xaa}
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}, To: &to, GasUsed: 21000})
+	s.Add(types.CallResult{Status: false, From: types.Address{0x02}, GasUsed: 5000})
+
+	path := filepath.Join(t.TempDir(), "history-export.csv")
+	if err := s.Export("csv", path); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	content := string(data)
+
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want a header plus 2 rows:\n%s", len(lines), content)
+	}
+	if lines[0] != "timestamp,type,caller,target,value,gasUsed,success" {
+		t.Errorf("got header %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "0x0100000000000000000000000000000000000000") || !strings.Contains(lines[1], "true") {
+		t.Errorf("got row %q, want caller and success=true", lines[1])
+	}
+	if !strings.Contains(lines[2], "false") {
+		t.Errorf("got row %q, want success=false", lines[2])
+	}
+}
+
+func TestExportJSONWritesAllEntries(t *testing.T) {
+	s := NewStore()
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}, GasUsed: 21000})
+	s.Add(types.CallResult{Status: true, From: types.Address{0x02}, GasUsed: 42000})
+
+	path := filepath.Join(t.TempDir(), "history-export.json")
+	if err := s.Export("json", path); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	var rows []exportRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		t.Fatalf("decoding export: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].GasUsed != 21000 || rows[1].GasUsed != 42000 {
+		t.Errorf("got gasUsed %d and %d, want 21000 and 42000", rows[0].GasUsed, rows[1].GasUsed)
+	}
+	if rows[0].Type != "call" {
+		t.Errorf("got type %q, want call", rows[0].Type)
+	}
+}
+
+func TestExportRejectsUnknownFormat(t *testing.T) {
+	s := NewStore()
+	path := filepath.Join(t.TempDir(), "history-export.txt")
+	if err := s.Export("yaml", path); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestExportMarksContractCreation(t *testing.T) {
+	s := NewStore()
+	deployed := types.Address{0xcc}
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}, DeployedAddress: &deployed})
+
+	path := filepath.Join(t.TempDir(), "history-export.json")
+	if err := s.Export("json", path); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	var rows []exportRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		t.Fatalf("decoding export: %v", err)
+	}
+	if rows[0].Type != "create" {
+		t.Errorf("got type %q, want create", rows[0].Type)
+	}
+	if rows[0].Target != "" {
+		t.Errorf("got target %q, want empty for a contract creation", rows[0].Target)
+	}
+}
@@ -0,0 +1,124 @@
+// Package history keeps an append-only log of every call executed in
+// the current chop session, by default in memory (see Store); see
+// JSONLStore for a disk-backed alternative that survives restarts.
+package history
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// Entry pairs a recorded call with the sequence number and time it was
+// recorded at.
+type Entry struct {
+	ID        uint64           `json:"id"`
+	Timestamp time.Time        `json:"timestamp"`
+	Call      types.CallResult `json:"call"`
+}
+
+// Recorder is the interface chop's call-history backends implement.
+// Store, the default, keeps every entry in memory. Other backends -
+// e.g. JSONLStore, which persists to disk - can be swapped in via
+// blockchain.Chain.History without any caller needing to change.
+type Recorder interface {
+	Add(call types.CallResult) Entry
+	All() []Entry
+	FilterCalls(query string) []Entry
+	Reset()
+}
+
+var _ Recorder = (*Store)(nil)
+
+// Store is a thread-safe append-only log of Entry values.
+type Store struct {
+	mu      sync.RWMutex
+	entries []Entry
+	nextID  uint64
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return &Store{}
+}
+
+// Add appends call to the log and returns the Entry it was recorded as.
+func (s *Store) Add(call types.CallResult) Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	e := Entry{ID: s.nextID, Timestamp: time.Now(), Call: call}
+	s.entries = append(s.entries, e)
+	return e
+}
+
+// All returns a copy of every recorded entry, oldest first.
+func (s *Store) All() []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]Entry, len(s.entries))
+	copy(out, s.entries)
+	return out
+}
+
+// FilterCalls returns every recorded entry whose caller address,
+// target address, or status (matching "success" or "failed")
+// case-insensitively contains query, oldest first. query is matched
+// as a partial hex substring for addresses, so "a1" matches any
+// address containing those digits; a leading "0x" is ignored. An
+// empty query behaves like All.
+func (s *Store) FilterCalls(query string) []Entry {
+	if query == "" {
+		return s.All()
+	}
+	needle := strings.ToLower(strings.TrimPrefix(query, "0x"))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []Entry
+	for _, e := range s.entries {
+		if matchesQuery(e.Call, needle) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// matchesQuery reports whether call's caller, target, or status
+// contains needle.
+func matchesQuery(call types.CallResult, needle string) bool {
+	if strings.Contains(strings.ToLower(fmt.Sprintf("%x", call.From)), needle) {
+		return true
+	}
+	if call.To != nil && strings.Contains(strings.ToLower(fmt.Sprintf("%x", *call.To)), needle) {
+		return true
+	}
+	status := "success"
+	if !call.Status {
+		status = "failed"
+	}
+	return strings.Contains(status, needle)
+}
+
+// addEntry appends a pre-built Entry (e.g. one loaded back from disk)
+// without allocating it a new ID, advancing nextID to match if
+// needed.
+func (s *Store) addEntry(e Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+	if e.ID > s.nextID {
+		s.nextID = e.ID
+	}
+}
+
+// Reset clears the log.
+func (s *Store) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = nil
+	s.nextID = 0
+}
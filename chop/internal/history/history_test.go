@@ -0,0 +1,55 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestFilterCallsMatchesPartialAddress(t *testing.T) {
+	s := NewStore()
+	to := types.Address{0xaa, 0xbb}
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}, To: &to})
+	s.Add(types.CallResult{Status: true, From: types.Address{0x02}})
+
+	got := s.FilterCalls("aabb")
+	if len(got) != 1 {
+		t.Fatalf("got %d entries, want 1", len(got))
+	}
+	if *got[0].Call.To != to {
+		t.Errorf("got To %x, want %x", *got[0].Call.To, to)
+	}
+}
+
+func TestFilterCallsMatchesFailedStatus(t *testing.T) {
+	s := NewStore()
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}})
+	s.Add(types.CallResult{Status: false, From: types.Address{0x02}})
+
+	got := s.FilterCalls("failed")
+	if len(got) != 1 {
+		t.Fatalf("got %d entries, want 1", len(got))
+	}
+	if got[0].Call.Status {
+		t.Error("expected the matched entry to have failed")
+	}
+}
+
+func TestFilterCallsEmptyQueryReturnsEverything(t *testing.T) {
+	s := NewStore()
+	s.Add(types.CallResult{Status: true})
+	s.Add(types.CallResult{Status: false})
+
+	if got := s.FilterCalls(""); len(got) != 2 {
+		t.Errorf("got %d entries, want 2", len(got))
+	}
+}
+
+func TestFilterCallsNoMatchesReturnsEmpty(t *testing.T) {
+	s := NewStore()
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}})
+
+	if got := s.FilterCalls("deadbeef"); len(got) != 0 {
+		t.Errorf("got %d entries, want 0", len(got))
+	}
+}
@@ -0,0 +1,157 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// JSONLStore is a Recorder that persists entries as one JSON object
+// per line, appended to a file, so call history survives process
+// restarts. Add, All, FilterCalls, and Reset go through an in-memory
+// cache (so a disk write failure can't fail the call that produced
+// the entry being recorded); FilterByStatus and FilterByDate instead
+// stream the file line by line, so filtering a long-running history
+// doesn't require loading it all into memory first.
+type JSONLStore struct {
+	mu    sync.Mutex
+	path  string
+	cache *Store
+}
+
+var _ Recorder = (*JSONLStore)(nil)
+
+// NewJSONLStore opens (or creates) the JSONL history file at path,
+// loading any entries already recorded there into its in-memory
+// cache.
+func NewJSONLStore(path string) (*JSONLStore, error) {
+	s := &JSONLStore{path: path, cache: NewStore()}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *JSONLStore) load() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("history: opening %s: %w", s.path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return fmt.Errorf("history: parsing %s: %w", s.path, err)
+		}
+		s.cache.addEntry(e)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("history: reading %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// Add appends call to the in-memory cache and persists it to disk. If
+// the disk write fails, the entry is still returned and kept in the
+// cache for the rest of this session; the failure is reported to
+// stderr rather than lost.
+func (s *JSONLStore) Add(call types.CallResult) Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.cache.Add(call)
+	if err := s.appendLine(e); err != nil {
+		fmt.Fprintf(os.Stderr, "chop: history: persisting entry %d: %v\n", e.ID, err)
+	}
+	return e
+}
+
+func (s *JSONLStore) appendLine(e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// All returns every cached entry, oldest first.
+func (s *JSONLStore) All() []Entry {
+	return s.cache.All()
+}
+
+// FilterCalls delegates to the in-memory cache; see Store.FilterCalls.
+func (s *JSONLStore) FilterCalls(query string) []Entry {
+	return s.cache.FilterCalls(query)
+}
+
+// Reset clears both the in-memory cache and the on-disk file.
+func (s *JSONLStore) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Reset()
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "chop: history: clearing %s: %v\n", s.path, err)
+	}
+}
+
+// FilterByStatus streams the on-disk file line by line, returning
+// every entry whose Call.Status equals success, without loading the
+// whole file into memory at once.
+func (s *JSONLStore) FilterByStatus(success bool) ([]Entry, error) {
+	return s.filterStream(func(e Entry) bool { return e.Call.Status == success })
+}
+
+// FilterByDate streams the on-disk file line by line, returning every
+// entry whose Timestamp falls within [from, to] inclusive, without
+// loading the whole file into memory at once.
+func (s *JSONLStore) FilterByDate(from, to time.Time) ([]Entry, error) {
+	return s.filterStream(func(e Entry) bool {
+		return !e.Timestamp.Before(from) && !e.Timestamp.After(to)
+	})
+}
+
+func (s *JSONLStore) filterStream(match func(Entry) bool) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("history: opening %s: %w", s.path, err)
+	}
+	defer f.Close()
+
+	var out []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("history: parsing %s: %w", s.path, err)
+		}
+		if match(e) {
+			out = append(out, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("history: reading %s: %w", s.path, err)
+	}
+	return out, nil
+}
@@ -0,0 +1,111 @@
+package history
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestJSONLStoreAddPersistsAcrossReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	s, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONLStore: %v", err)
+	}
+	s.Add(types.CallResult{Status: true, From: types.Address{0x01}, GasUsed: 21000})
+	s.Add(types.CallResult{Status: false, From: types.Address{0x02}, GasUsed: 5000})
+
+	reloaded, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+	entries := reloaded.All()
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries after reload, want 2", len(entries))
+	}
+	if entries[0].ID != 1 || entries[1].ID != 2 {
+		t.Errorf("got IDs %d,%d after reload, want 1,2", entries[0].ID, entries[1].ID)
+	}
+}
+
+func TestJSONLStoreFilterByStatus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONLStore: %v", err)
+	}
+	s.Add(types.CallResult{Status: true, GasUsed: 1})
+	s.Add(types.CallResult{Status: false, GasUsed: 2})
+	s.Add(types.CallResult{Status: true, GasUsed: 3})
+
+	succeeded, err := s.FilterByStatus(true)
+	if err != nil {
+		t.Fatalf("FilterByStatus(true): %v", err)
+	}
+	if len(succeeded) != 2 {
+		t.Fatalf("got %d successful entries, want 2", len(succeeded))
+	}
+	for _, e := range succeeded {
+		if !e.Call.Status {
+			t.Errorf("FilterByStatus(true) returned a failed call: %+v", e)
+		}
+	}
+
+	failed, err := s.FilterByStatus(false)
+	if err != nil {
+		t.Fatalf("FilterByStatus(false): %v", err)
+	}
+	if len(failed) != 1 || failed[0].Call.GasUsed != 2 {
+		t.Errorf("got %+v, want a single failed entry with GasUsed 2", failed)
+	}
+}
+
+func TestJSONLStoreFilterByDate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONLStore: %v", err)
+	}
+	s.Add(types.CallResult{Status: true})
+
+	now := s.All()[0].Timestamp
+	inRange, err := s.FilterByDate(now.Add(-time.Minute), now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("FilterByDate: %v", err)
+	}
+	if len(inRange) != 1 {
+		t.Errorf("got %d entries in range, want 1", len(inRange))
+	}
+
+	outOfRange, err := s.FilterByDate(now.Add(time.Hour), now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("FilterByDate: %v", err)
+	}
+	if len(outOfRange) != 0 {
+		t.Errorf("got %d entries out of range, want 0", len(outOfRange))
+	}
+}
+
+func TestJSONLStoreResetClearsDiskAndCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONLStore: %v", err)
+	}
+	s.Add(types.CallResult{Status: true})
+	s.Reset()
+
+	if len(s.All()) != 0 {
+		t.Error("want All empty after Reset")
+	}
+	reloaded, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("reopening after Reset: %v", err)
+	}
+	if len(reloaded.All()) != 0 {
+		t.Error("want reloaded store empty after Reset")
+	}
+}
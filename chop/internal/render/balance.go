@@ -0,0 +1,33 @@
+package render
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// defaultDecimals is used whenever a chain's native currency decimals
+// aren't known. It matches ether/wei and most EVM chains.
+const defaultDecimals = 18
+
+// FormatBalance renders a raw balance (e.g. wei) as a decimal string
+// with the given number of decimals, trimming trailing zeros so a
+// whole amount prints as "1" rather than "1.000000000000000000".
+// decimals of 0 is treated as unknown and defaults to 18; pass the
+// active chain's NativeCurrency.Decimals for chains whose native
+// asset isn't 18 decimals (e.g. an 8-decimal native asset), or
+// balances will display far too large.
+func FormatBalance(balance *big.Int, decimals uint8) string {
+	if decimals == 0 {
+		decimals = defaultDecimals
+	}
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	quotient, remainder := new(big.Int), new(big.Int)
+	quotient.DivMod(balance, divisor, remainder)
+
+	if remainder.Sign() == 0 {
+		return quotient.String()
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%0*s", int(decimals), remainder.String()), "0")
+	return quotient.String() + "." + fraction
+}
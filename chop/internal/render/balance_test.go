@@ -0,0 +1,40 @@
+package render
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFormatBalanceWholeAmountAtEightDecimals(t *testing.T) {
+	wei := new(big.Int).Exp(big.NewInt(10), big.NewInt(8), nil)
+	if got := FormatBalance(wei, 8); got != "1" {
+		t.Errorf("got %q, want \"1\"", got)
+	}
+}
+
+func TestFormatBalanceWholeAmountAtEighteenDecimals(t *testing.T) {
+	wei := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	if got := FormatBalance(wei, 18); got != "1" {
+		t.Errorf("got %q, want \"1\"", got)
+	}
+}
+
+func TestFormatBalanceTrimsTrailingZerosInFraction(t *testing.T) {
+	wei := big.NewInt(1_500_000_000) // 1.5 at 9 decimals
+	if got := FormatBalance(wei, 9); got != "1.5" {
+		t.Errorf("got %q, want \"1.5\"", got)
+	}
+}
+
+func TestFormatBalanceDefaultsToEighteenDecimalsWhenUnknown(t *testing.T) {
+	wei := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	if got := FormatBalance(wei, 0); got != "1" {
+		t.Errorf("got %q, want \"1\"", got)
+	}
+}
+
+func TestFormatBalanceZero(t *testing.T) {
+	if got := FormatBalance(big.NewInt(0), 18); got != "0" {
+		t.Errorf("got %q, want \"0\"", got)
+	}
+}
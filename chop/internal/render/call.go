@@ -0,0 +1,50 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// RenderCallResult formats a CallResult for the detail view. When full
+// is false, hex fields (output, log data) are truncated to
+// truncateLen characters; when true, every byte is shown.
+func RenderCallResult(r types.CallResult, truncateLen int, full bool) string {
+	maxLen := truncateLen
+	if full {
+		maxLen = 0
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: %v\n", r.Status)
+	fmt.Fprintf(&b, "gasUsed: %d / %d\n", r.GasUsed, r.GasLimit)
+	fmt.Fprintf(&b, "output: %s\n", TruncateHex(r.Output, maxLen))
+	for i, log := range r.Logs {
+		fmt.Fprintf(&b, "log[%d]:\n", i)
+		for _, line := range strings.Split(strings.TrimRight(RenderLogDetail(log, truncateLen, full), "\n"), "\n") {
+			fmt.Fprintf(&b, "  %s\n", line)
+		}
+	}
+	if r.Error != "" {
+		fmt.Fprintf(&b, "error: %s\n", r.Error)
+	}
+	if r.RevertReason != "" {
+		fmt.Fprintf(&b, "revertReason: %s\n", r.RevertReason)
+	}
+	if r.PanicCode != nil {
+		fmt.Fprintf(&b, "panicCode: 0x%x\n", *r.PanicCode)
+	}
+	if len(r.FinalStack) > 0 {
+		fmt.Fprintf(&b, "finalStack: %s\n", strings.Join(r.FinalStack, ", "))
+	}
+	if len(r.FinalMemory) > 0 {
+		fmt.Fprintf(&b, "finalMemory: %s\n", TruncateHex(r.FinalMemory, maxLen))
+	}
+	if len(r.TransientStorage) > 0 {
+		fmt.Fprintf(&b, "transientStorage (cleared at end of tx):\n")
+		for slot, value := range r.TransientStorage {
+			fmt.Fprintf(&b, "  %s => %s\n", slot, value)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestRenderCallResultShowsFinalStackAndMemoryOnRevert(t *testing.T) {
+	r := types.CallResult{
+		Status:      false,
+		Error:       "execution reverted",
+		FinalStack:  []string{"0x0000000000000000000000000000000000000000000000000000000000000001", "0x02"},
+		FinalMemory: []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	got := RenderCallResult(r, 0, true)
+	if !strings.Contains(got, "finalStack: 0x0000000000000000000000000000000000000000000000000000000000000001, 0x02") {
+		t.Errorf("got %q, want a finalStack line", got)
+	}
+	if !strings.Contains(got, "finalMemory: 0xdeadbeef") {
+		t.Errorf("got %q, want a finalMemory line", got)
+	}
+}
+
+func TestRenderCallResultOmitsCaptureFieldsWhenAbsent(t *testing.T) {
+	r := types.CallResult{Status: true}
+
+	got := RenderCallResult(r, 0, true)
+	if strings.Contains(got, "finalStack") || strings.Contains(got, "finalMemory") || strings.Contains(got, "transientStorage") {
+		t.Errorf("got %q, want no capture-state lines when not populated", got)
+	}
+}
+
+func TestRenderCallResultShowsTransientStorageDistinctFromFinalMemory(t *testing.T) {
+	r := types.CallResult{
+		Status:           true,
+		FinalMemory:      []byte{0xde, 0xad},
+		TransientStorage: map[string]string{"0x01": "0x2a"},
+	}
+
+	got := RenderCallResult(r, 0, true)
+	if !strings.Contains(got, "transientStorage (cleared at end of tx):") {
+		t.Errorf("got %q, want a transientStorage heading", got)
+	}
+	if !strings.Contains(got, "0x01 => 0x2a") {
+		t.Errorf("got %q, want the captured slot/value pair", got)
+	}
+}
@@ -0,0 +1,20 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// RenderCreatePrediction formats the address a plain CREATE
+// deployment from deployer will end up at, using deployer's current
+// nonce from manager, for display alongside the call parameters
+// before the call runs. No TUI view calls it; tui.FunctionSelectModel,
+// the parameter editor, shows only the encoded calldata.
+func RenderCreatePrediction(manager *accounts.Manager, deployer types.Address) string {
+	nonce := manager.GetAccount(deployer).Nonce
+	addr := evm.PredictCreateAddress(deployer, nonce)
+	return fmt.Sprintf("predicted address: 0x%x", addr)
+}
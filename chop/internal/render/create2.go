@@ -0,0 +1,18 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// RenderCreate2Prediction formats the address a CREATE2 deployment
+// from deployer with salt and initCode will end up at, for display
+// alongside the call parameters before the call runs. No TUI view
+// calls it; tui.FunctionSelectModel, the parameter editor, shows only
+// the encoded calldata.
+func RenderCreate2Prediction(deployer types.Address, salt types.Hash, initCode []byte) string {
+	addr := evm.PredictCreate2Address(deployer, salt, initCode)
+	return fmt.Sprintf("predicted address: 0x%x", addr)
+}
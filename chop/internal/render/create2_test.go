@@ -0,0 +1,18 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestRenderCreate2PredictionShowsPredictedAddress(t *testing.T) {
+	var deployer types.Address
+	var salt types.Hash
+
+	got := RenderCreate2Prediction(deployer, salt, []byte{0x00})
+	want := "predicted address: 0x4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,26 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestRenderCreatePredictionUsesDeployerNonceFromManager(t *testing.T) {
+	manager := accounts.NewManager()
+	deployer := types.Address{0x6a, 0xc7, 0xea, 0x33, 0xf8, 0x83, 0x1e, 0xa9, 0xdc, 0xc5, 0x33, 0x93, 0xaa, 0xa8, 0x8b, 0x25, 0xa7, 0x85, 0xdb, 0xf0}
+
+	got := RenderCreatePrediction(manager, deployer)
+	want := "predicted address: 0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	manager.IncrementNonce(deployer)
+	got = RenderCreatePrediction(manager, deployer)
+	want = "predicted address: 0x343c43a37d37dff08ae8c4a11544c718abb4fcf8"
+	if got != want {
+		t.Errorf("after incrementing the nonce: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+// gasProfileBarWidth is the widest a category's bar can render,
+// scaled to the category with the most gas.
+const gasProfileBarWidth = 20
+
+// RenderGasProfile renders a gas-by-category profile (see
+// evm.GasProfile) as a small horizontal bar chart, one line per
+// non-empty category in bytecode.GasCategories order.
+func RenderGasProfile(profile map[string]uint64) string {
+	var max uint64
+	for _, gas := range profile {
+		if gas > max {
+			max = gas
+		}
+	}
+	if max == 0 {
+		return "no gas-profiling data\n"
+	}
+
+	var sb strings.Builder
+	for _, category := range bytecode.GasCategories {
+		gas := profile[category]
+		if gas == 0 {
+			continue
+		}
+		barLen := int(gas * gasProfileBarWidth / max)
+		if barLen == 0 {
+			barLen = 1
+		}
+		fmt.Fprintf(&sb, "%-10s %s %d\n", category, strings.Repeat("#", barLen), gas)
+	}
+	return sb.String()
+}
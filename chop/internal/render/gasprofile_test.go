@@ -0,0 +1,32 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderGasProfileShowsEachNonEmptyCategory(t *testing.T) {
+	profile := map[string]uint64{"arithmetic": 3, "storage": 40000, "other": 6}
+
+	got := RenderGasProfile(profile)
+	for _, want := range []string{"arithmetic", "storage", "other"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %q, want a line mentioning %q", got, want)
+		}
+	}
+}
+
+func TestRenderGasProfileSkipsEmptyCategories(t *testing.T) {
+	profile := map[string]uint64{"storage": 100}
+
+	got := RenderGasProfile(profile)
+	if strings.Contains(got, "calls") {
+		t.Errorf("got %q, want no line for a category with zero gas", got)
+	}
+}
+
+func TestRenderGasProfileHandlesEmptyProfile(t *testing.T) {
+	if got := RenderGasProfile(map[string]uint64{}); got == "" {
+		t.Error("expected a non-empty message for an empty profile")
+	}
+}
@@ -0,0 +1,19 @@
+// Package render formats chop's domain types for display in the TUI
+// and CLI output.
+package render
+
+import "fmt"
+
+// TruncateHex renders data as 0x-prefixed hex, collapsing anything
+// longer than maxLen hex characters to its first and last few bytes
+// with an ellipsis in between so the value stays recognizable.
+// maxLen <= 0 disables truncation.
+func TruncateHex(data []byte, maxLen int) string {
+	full := fmt.Sprintf("0x%x", data)
+	if maxLen <= 0 || len(full) <= maxLen {
+		return full
+	}
+	head := full[:maxLen/2]
+	tail := full[len(full)-maxLen/2:]
+	return head + "..." + tail
+}
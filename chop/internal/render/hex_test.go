@@ -0,0 +1,26 @@
+package render
+
+import "testing"
+
+func TestTruncateHexShort(t *testing.T) {
+	got := TruncateHex([]byte{0xde, 0xad}, 64)
+	if got != "0xdead" {
+		t.Errorf("want 0xdead, got %s", got)
+	}
+}
+
+func TestTruncateHexLongCollapses(t *testing.T) {
+	data := make([]byte, 100)
+	got := TruncateHex(data, 20)
+	if len(got) != 23 { // 10 head + "..." + 10 tail
+		t.Errorf("want collapsed length 23, got %d (%s)", len(got), got)
+	}
+}
+
+func TestTruncateHexDisabled(t *testing.T) {
+	data := make([]byte, 100)
+	got := TruncateHex(data, 0)
+	if len(got) != 202 { // "0x" + 200 hex chars
+		t.Errorf("want untruncated length 202, got %d", len(got))
+	}
+}
@@ -0,0 +1,23 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evmts/chop/internal/history"
+)
+
+// RenderHistoryDiff formats the DiffFields from history.Diff as a
+// three-column table (field, A, B), marking each differing row with a
+// leading "*" so it stands out without relying on color.
+func RenderHistoryDiff(fields []history.DiffField) string {
+	var b strings.Builder
+	for _, f := range fields {
+		marker := " "
+		if f.Differs {
+			marker = "*"
+		}
+		fmt.Fprintf(&b, "%s %-8s a=%s  b=%s\n", marker, f.Name, f.A, f.B)
+	}
+	return b.String()
+}
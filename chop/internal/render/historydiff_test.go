@@ -0,0 +1,28 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evmts/chop/internal/history"
+)
+
+func TestRenderHistoryDiffMarksDifferingFields(t *testing.T) {
+	fields := []history.DiffField{
+		{Name: "gasUsed", A: "21000", B: "23000", Differs: true},
+		{Name: "status", A: "true", B: "true", Differs: false},
+	}
+
+	got := RenderHistoryDiff(fields)
+
+	lines := strings.Split(strings.TrimRight(got, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2: %q", len(lines), got)
+	}
+	if !strings.HasPrefix(lines[0], "*") {
+		t.Errorf("got %q, want the differing gasUsed row marked with *", lines[0])
+	}
+	if strings.HasPrefix(lines[1], "*") {
+		t.Errorf("got %q, want the matching status row unmarked", lines[1])
+	}
+}
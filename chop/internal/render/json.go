@@ -0,0 +1,54 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+// callResultJSON is the stable machine-readable shape for a
+// CallResult, used by `chop call --output json` so scripts can pipe
+// chop into jq without depending on Go struct field names directly.
+type callResultJSON struct {
+	Success         bool      `json:"success"`
+	GasUsed         uint64    `json:"gasUsed"`
+	GasLeft         uint64    `json:"gasLeft"`
+	ReturnData      string    `json:"returnData"`
+	DeployedAddress *string   `json:"deployedAddress,omitempty"`
+	Logs            []logJSON `json:"logs"`
+}
+
+type logJSON struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// CallResultJSON marshals r into the stable JSON shape consumed by
+// `chop call --output json`.
+func CallResultJSON(r types.CallResult) ([]byte, error) {
+	out := callResultJSON{
+		Success:    r.Status,
+		GasUsed:    r.GasUsed,
+		GasLeft:    r.GasLimit - r.GasUsed,
+		ReturnData: TruncateHex(r.Output, 0),
+		Logs:       make([]logJSON, len(r.Logs)),
+	}
+	if r.DeployedAddress != nil {
+		addr := fmt.Sprintf("0x%x", *r.DeployedAddress)
+		out.DeployedAddress = &addr
+	}
+	for i, log := range r.Logs {
+		topics := make([]string, len(log.Topics))
+		for j, t := range log.Topics {
+			topics[j] = fmt.Sprintf("0x%x", t)
+		}
+		out.Logs[i] = logJSON{
+			Address: fmt.Sprintf("0x%x", log.Address),
+			Topics:  topics,
+			Data:    TruncateHex(log.Data, 0),
+		}
+	}
+	return json.Marshal(out)
+}
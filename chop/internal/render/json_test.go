@@ -0,0 +1,32 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestCallResultJSONGolden(t *testing.T) {
+	addr := types.Address{0x01}
+	deployed := types.Address{0x02}
+	r := types.CallResult{
+		Status:          true,
+		GasUsed:         21000,
+		GasLimit:        100000,
+		Output:          []byte{0xde, 0xad, 0xbe, 0xef},
+		DeployedAddress: &deployed,
+		Logs: []types.Log{
+			{Address: addr, Topics: []types.Hash{{0x01}}, Data: []byte{0x42}},
+		},
+	}
+
+	got, err := CallResultJSON(r)
+	if err != nil {
+		t.Fatalf("CallResultJSON: %v", err)
+	}
+
+	want := `{"success":true,"gasUsed":21000,"gasLeft":79000,"returnData":"0xdeadbeef","deployedAddress":"0x0200000000000000000000000000000000000000","logs":[{"address":"0x0100000000000000000000000000000000000000","topics":["0x0100000000000000000000000000000000000000000000000000000000000000"],"data":"0x42"}]}`
+	if string(got) != want {
+		t.Errorf("golden mismatch:\ngot  %s\nwant %s", got, want)
+	}
+}
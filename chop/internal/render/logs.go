@@ -0,0 +1,31 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evmts/chop/internal/abi"
+	"github.com/evmts/chop/internal/types"
+)
+
+// RenderLogDetail formats a single log's raw address/topics/data, plus
+// a decoded event line when abi.DecodeLog recognizes it. Raw display
+// is always included so an unrecognized or custom event is still
+// fully inspectable.
+func RenderLogDetail(log types.Log, truncateLen int, full bool) string {
+	maxLen := truncateLen
+	if full {
+		maxLen = 0
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "address: 0x%x\n", log.Address)
+	for i, topic := range log.Topics {
+		fmt.Fprintf(&b, "topic[%d]: 0x%x\n", i, topic)
+	}
+	fmt.Fprintf(&b, "data: %s\n", TruncateHex(log.Data, maxLen))
+	if decoded, ok := abi.DecodeLog(log); ok {
+		fmt.Fprintf(&b, "decoded: %s(from=0x%x, to=0x%x, value=%s)\n",
+			decoded.Event, decoded.From, decoded.To, decoded.Value)
+	}
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuthTokenAcceptsCorrectBearerToken(t *testing.T) {
+	s := New()
+	s.SetAuthToken("s3cret")
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	req.Header.Set("Authorization", "Bearer s3cret")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("got status %d, want 200 with the correct token", rec.Code)
+	}
+}
+
+func TestAuthTokenRejectsWrongBearerToken(t *testing.T) {
+	s := New()
+	s.SetAuthToken("s3cret")
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if rec.Code != 401 {
+		t.Fatalf("got status %d, want 401 with the wrong token", rec.Code)
+	}
+}
+
+func TestAuthTokenRejectsMissingHeader(t *testing.T) {
+	s := New()
+	s.SetAuthToken("s3cret")
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if rec.Code != 401 {
+		t.Fatalf("got status %d, want 401 with no Authorization header", rec.Code)
+	}
+}
+
+func TestHealthEndpointUnauthenticatedEvenWithAuthTokenSet(t *testing.T) {
+	s := New()
+	s.SetAuthToken("s3cret")
+
+	req := httptest.NewRequest("GET", "/health", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("got status %d, want 200 for /health with no Authorization header", rec.Code)
+	}
+}
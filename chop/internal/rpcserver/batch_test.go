@@ -0,0 +1,82 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBatchRequestDispatchesEachElementPreservingIDs(t *testing.T) {
+	s := New()
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]},
+		{"jsonrpc":"2.0","id":2,"method":"chop_unknown","params":[]},
+		{"jsonrpc":"2.0","id":3,"method":"chop_ping","params":[]}
+	]`
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var got []map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding batch response: %v (body=%s)", err, rec.Body.String())
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d responses, want 3", len(got))
+	}
+	if got[0]["id"].(float64) != 1 || got[0]["result"] != "pong" {
+		t.Errorf("got response[0] %v, want id 1 result pong", got[0])
+	}
+	if got[1]["id"].(float64) != 2 {
+		t.Errorf("got response[1] id %v, want 2", got[1]["id"])
+	}
+	if _, hasError := got[1]["error"]; !hasError {
+		t.Error("want response[1] to carry an error for the unknown method")
+	}
+	if got[2]["id"].(float64) != 3 || got[2]["result"] != "pong" {
+		t.Errorf("got response[2] %v, want id 3 result pong", got[2])
+	}
+}
+
+func TestSingleObjectRequestStillWorks(t *testing.T) {
+	s := New()
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding response: %v (body=%s)", err, rec.Body.String())
+	}
+	if got["result"] != "pong" {
+		t.Errorf("got result %v, want pong", got["result"])
+	}
+}
+
+func TestBatchRequestRejectedPastMaxBatchSize(t *testing.T) {
+	s := New()
+	s.SetMaxBatchSize(2)
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]},
+		{"jsonrpc":"2.0","id":2,"method":"chop_ping","params":[]},
+		{"jsonrpc":"2.0","id":3,"method":"chop_ping","params":[]}
+	]`
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding response: %v (body=%s)", err, rec.Body.String())
+	}
+	if _, hasError := got["error"]; !hasError {
+		t.Error("want a single error response rejecting the oversized batch")
+	}
+}
@@ -0,0 +1,18 @@
+package rpcserver
+
+import (
+	"encoding/json"
+
+	"github.com/evmts/chop/internal/blockchain"
+)
+
+// RegisterChopMethods adds the chop_-namespaced introspection methods
+// backed by the given chain.
+func RegisterChopMethods(s *Server, chain *blockchain.Chain) {
+	// chop_contractStats returns every contract touched this session,
+	// sorted by call count then gas used, for the Contracts analytics
+	// view.
+	s.Register("chop_contractStats", func(_ json.RawMessage) (any, error) {
+		return chain.Contracts.Sorted(), nil
+	})
+}
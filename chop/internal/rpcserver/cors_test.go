@@ -0,0 +1,51 @@
+package rpcserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORSAllowedOrigin(t *testing.T) {
+	s := New()
+	s.SetAllowedOrigins([]string{"https://app.example.com"})
+
+	req := httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("got Access-Control-Allow-Origin %q, want https://app.example.com", got)
+	}
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("got status %d for preflight, want 204", rec.Code)
+	}
+}
+
+func TestCORSDisallowedOrigin(t *testing.T) {
+	s := New()
+	s.SetAllowedOrigins([]string{"https://app.example.com"})
+
+	req := httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("got Access-Control-Allow-Origin %q for a disallowed origin, want none", got)
+	}
+}
+
+func TestCORSDefaultAllowsEverything(t *testing.T) {
+	s := New()
+
+	req := httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://anything.example.com")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("got Access-Control-Allow-Origin %q, want *", got)
+	}
+}
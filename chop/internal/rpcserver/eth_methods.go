@@ -0,0 +1,681 @@
+package rpcserver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/evmts/chop/internal/accounts"
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/bytecode"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// callObject is the standard eth_call/eth_estimateGas transaction
+// object, with every field optional per the JSON-RPC spec.
+type callObject struct {
+	To    string `json:"to"`
+	From  string `json:"from"`
+	Data  string `json:"data"`
+	Value string `json:"value"`
+	Gas   string `json:"gas"`
+}
+
+// RegisterEthMethods adds the standard eth_*-namespaced methods,
+// executing calls against exec and reading/recording state on chain.
+// exec is an evm.Executor rather than a concrete *evm.EVM so tests can
+// inject a fake instead of shelling out to a real guillotine binary.
+func RegisterEthMethods(s *Server, chain *blockchain.Chain, exec evm.Executor) {
+	s.Register("eth_chainId", func(_ json.RawMessage) (any, error) {
+		return encodeQuantity(s.ChainID()), nil
+	})
+
+	s.Register("net_version", func(_ json.RawMessage) (any, error) {
+		return strconv.FormatUint(s.ChainID(), 10), nil
+	})
+
+	s.Register("eth_call", func(params json.RawMessage) (any, error) {
+		call, blockTag, err := parseCallParams(params)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateBlockTag(blockTag); err != nil {
+			return nil, err
+		}
+
+		ctx, err := callObjectToContext(call, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := executeReadOnly(exec, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Status {
+			reason := evm.DecodeRevertReason(result.Output)
+			msg := "execution reverted"
+			if reason != "" {
+				msg += ": " + reason
+			}
+			return nil, &revertError{message: msg, data: "0x" + hex.EncodeToString(result.Output)}
+		}
+		chain.RecordCall(fillCallResult(result, ctx))
+		return "0x" + hex.EncodeToString(result.Output), nil
+	})
+
+	s.Register("eth_blockNumber", func(_ json.RawMessage) (any, error) {
+		return encodeQuantity(chain.Head().Number), nil
+	})
+
+	s.Register("eth_gasPrice", func(_ json.RawMessage) (any, error) {
+		return encodeQuantity(s.GasPrice()), nil
+	})
+
+	s.Register("eth_getBalance", func(params json.RawMessage) (any, error) {
+		addr, _, err := parseAddressAndBlockTag(params)
+		if err != nil {
+			return nil, err
+		}
+		return encodeQuantityBig(chain.Accounts.GetAccount(addr).Balance), nil
+	})
+
+	s.Register("eth_getCode", func(params json.RawMessage) (any, error) {
+		addr, _, err := parseAddressAndBlockTag(params)
+		if err != nil {
+			return nil, err
+		}
+		return "0x" + hex.EncodeToString(chain.CodeAt(addr)), nil
+	})
+
+	s.Register("eth_getStorageAt", func(params json.RawMessage) (any, error) {
+		addr, slot, _, err := parseAddressSlotAndBlockTag(params)
+		if err != nil {
+			return nil, err
+		}
+		value := chain.StorageAt(addr, slot)
+		return "0x" + hex.EncodeToString(value[:]), nil
+	})
+
+	s.Register("eth_getBlockByNumber", func(params json.RawMessage) (any, error) {
+		tag, fullTx, err := parseBlockQueryParams(params)
+		if err != nil {
+			return nil, err
+		}
+		number, err := resolveBlockTag(tag, chain)
+		if err != nil {
+			return nil, err
+		}
+		block, ok := chain.BlockByNumber(number)
+		if !ok {
+			return nil, nil
+		}
+		return encodeBlock(block, fullTx), nil
+	})
+
+	s.Register("eth_getBlockByHash", func(params json.RawMessage) (any, error) {
+		hashHex, fullTx, err := parseBlockQueryParams(params)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := decodeHash(hashHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block hash: %w", err)
+		}
+		block, ok := chain.BlockByHash(hash)
+		if !ok {
+			return nil, nil
+		}
+		return encodeBlock(block, fullTx), nil
+	})
+
+	s.Register("eth_getTransactionByHash", func(params json.RawMessage) (any, error) {
+		hashHex, err := parseSingleHashParam(params)
+		if err != nil {
+			return nil, err
+		}
+		tx, ok := chain.TransactionByHash(hashHex)
+		if !ok {
+			return nil, nil
+		}
+		return encodeTransaction(tx), nil
+	})
+
+	s.Register("eth_getTransactionReceipt", func(params json.RawMessage) (any, error) {
+		hashHex, err := parseSingleHashParam(params)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := chain.GetReceipt(hashHex)
+		if err != nil {
+			return nil, nil
+		}
+		return encodeReceipt(*receipt), nil
+	})
+
+	s.Register("eth_getTransactionCount", func(params json.RawMessage) (any, error) {
+		addr, _, err := parseAddressAndBlockTag(params)
+		if err != nil {
+			return nil, err
+		}
+		return encodeQuantity(chain.Accounts.GetAccount(addr).Nonce), nil
+	})
+
+	s.Register("eth_sendTransaction", func(params json.RawMessage) (any, error) {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(params, &raw); err != nil || len(raw) == 0 {
+			return nil, fmt.Errorf("eth_sendTransaction requires a transaction object")
+		}
+		var call callObject
+		if err := json.Unmarshal(raw[0], &call); err != nil {
+			return nil, fmt.Errorf("invalid transaction object: %w", err)
+		}
+
+		ctx, err := callObjectToContext(call, chain)
+		if err != nil {
+			return nil, err
+		}
+		if ctx.GasLimit > chain.GetGasLimit() {
+			return nil, fmt.Errorf("gas limit %d exceeds block gas limit %d", ctx.GasLimit, chain.GetGasLimit())
+		}
+		ctx.EmitPostState = true
+
+		sender := chain.Accounts.GetAccount(ctx.From)
+		value := new(big.Int).SetUint64(ctx.Value)
+		if sender.Balance.Cmp(value) < 0 {
+			return nil, fmt.Errorf("insufficient balance: have %s, want to send %d", sender.Balance, ctx.Value)
+		}
+
+		result, err := exec.ExecuteCall(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Status {
+			reason := evm.DecodeRevertReason(result.Output)
+			msg := "execution reverted"
+			if reason != "" {
+				msg += ": " + reason
+			}
+			return nil, &revertError{message: msg, data: "0x" + hex.EncodeToString(result.Output)}
+		}
+
+		// Apply the balance transfer and nonce bump as a single atomic
+		// diff instead of separate Get/Set/Increment calls, so two
+		// concurrent eth_sendTransaction calls from the same sender
+		// can't both read the same starting balance and both deduct
+		// from it (see accounts.Manager.ApplyStateDiff).
+		balanceDeltas := map[types.Address]*big.Int{ctx.From: new(big.Int).Neg(value)}
+		if ctx.To != nil {
+			if existing, ok := balanceDeltas[*ctx.To]; ok {
+				balanceDeltas[*ctx.To] = new(big.Int).Add(existing, value)
+			} else {
+				balanceDeltas[*ctx.To] = new(big.Int).Set(value)
+			}
+		}
+		if err := chain.Accounts.ApplyStateDiff(accounts.StateDiff{
+			BalanceDeltas: balanceDeltas,
+			NonceDeltas:   map[types.Address]uint64{ctx.From: 1},
+		}); err != nil {
+			return nil, err
+		}
+		nonce := chain.Accounts.GetAccount(ctx.From).Nonce
+
+		if result.DeployedAddress != nil {
+			chain.SetCode(*result.DeployedAddress, result.Output)
+		}
+		applyPostState(chain, result.PostState)
+		chain.RecordCall(fillCallResult(result, ctx))
+
+		tx := types.Transaction{
+			From:            ctx.From,
+			To:              ctx.To,
+			Value:           ctx.Value,
+			Data:            ctx.Input,
+			GasLimit:        ctx.GasLimit,
+			GasUsed:         result.GasUsed,
+			Status:          result.Status,
+			Logs:            result.Logs,
+			DeployedAddress: result.DeployedAddress,
+		}
+		tx.Hash = blockchain.TransactionHash(tx, nonce)
+		chain.AddBlock([]types.Transaction{tx})
+
+		return "0x" + hex.EncodeToString(tx.Hash[:]), nil
+	})
+}
+
+// parseAddressAndBlockTag decodes the [address, blockTag] positional
+// params eth_getBalance and eth_getTransactionCount both take,
+// defaulting blockTag to "latest" when omitted.
+func parseAddressAndBlockTag(params json.RawMessage) (types.Address, string, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) == 0 {
+		return types.Address{}, "", fmt.Errorf("expected an address parameter")
+	}
+	var addrHex string
+	if err := json.Unmarshal(raw[0], &addrHex); err != nil {
+		return types.Address{}, "", fmt.Errorf("invalid address: %w", err)
+	}
+	addr, err := decodeAddress(addrHex)
+	if err != nil {
+		return types.Address{}, "", fmt.Errorf("invalid address: %w", err)
+	}
+	blockTag := "latest"
+	if len(raw) > 1 {
+		if err := json.Unmarshal(raw[1], &blockTag); err != nil {
+			return types.Address{}, "", fmt.Errorf("invalid block tag: %w", err)
+		}
+	}
+	if err := validateBlockTag(blockTag); err != nil {
+		return types.Address{}, "", err
+	}
+	return addr, blockTag, nil
+}
+
+// parseAddressSlotAndBlockTag decodes the [address, slot, blockTag]
+// positional params eth_getStorageAt takes, defaulting blockTag to
+// "latest" when omitted.
+func parseAddressSlotAndBlockTag(params json.RawMessage) (types.Address, types.Hash, string, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 2 {
+		return types.Address{}, types.Hash{}, "", fmt.Errorf("eth_getStorageAt requires an address and a slot")
+	}
+	var addrHex string
+	if err := json.Unmarshal(raw[0], &addrHex); err != nil {
+		return types.Address{}, types.Hash{}, "", fmt.Errorf("invalid address: %w", err)
+	}
+	addr, err := decodeAddress(addrHex)
+	if err != nil {
+		return types.Address{}, types.Hash{}, "", fmt.Errorf("invalid address: %w", err)
+	}
+	var slotHex string
+	if err := json.Unmarshal(raw[1], &slotHex); err != nil {
+		return types.Address{}, types.Hash{}, "", fmt.Errorf("invalid slot: %w", err)
+	}
+	slot, err := decodeHash(slotHex)
+	if err != nil {
+		return types.Address{}, types.Hash{}, "", fmt.Errorf("invalid slot: %w", err)
+	}
+	blockTag := "latest"
+	if len(raw) > 2 {
+		if err := json.Unmarshal(raw[2], &blockTag); err != nil {
+			return types.Address{}, types.Hash{}, "", fmt.Errorf("invalid block tag: %w", err)
+		}
+	}
+	if err := validateBlockTag(blockTag); err != nil {
+		return types.Address{}, types.Hash{}, "", err
+	}
+	return addr, slot, blockTag, nil
+}
+
+// decodeHash parses a 0x-prefixed, up-to-32-byte QUANTITY or DATA
+// string into a left-padded Hash, matching how eth_getStorageAt's
+// slot argument is conventionally encoded (e.g. "0x0" for slot 0).
+func decodeHash(s string) (types.Hash, error) {
+	trimmed := strings.TrimPrefix(s, "0x")
+	if len(trimmed)%2 != 0 {
+		trimmed = "0" + trimmed
+	}
+	b, err := hex.DecodeString(trimmed)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	if len(b) > 32 {
+		return types.Hash{}, fmt.Errorf("want at most 32 bytes, got %d", len(b))
+	}
+	var hash types.Hash
+	copy(hash[32-len(b):], b)
+	return hash, nil
+}
+
+// parseBlockQueryParams decodes the [identifier, fullTx] positional
+// params eth_getBlockByNumber and eth_getBlockByHash both take.
+// identifier is either a block tag/number or a block hash, depending
+// on the method; the caller interprets it.
+func parseBlockQueryParams(params json.RawMessage) (string, bool, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 1 {
+		return "", false, fmt.Errorf("expected a block identifier")
+	}
+	var ident string
+	if err := json.Unmarshal(raw[0], &ident); err != nil {
+		return "", false, fmt.Errorf("invalid block identifier: %w", err)
+	}
+	var fullTx bool
+	if len(raw) > 1 {
+		if err := json.Unmarshal(raw[1], &fullTx); err != nil {
+			return "", false, fmt.Errorf("invalid fullTx flag: %w", err)
+		}
+	}
+	return ident, fullTx, nil
+}
+
+// resolveBlockTag resolves tag to a concrete block number against
+// chain: "latest"/"pending" resolve to the chain head (chop has no
+// separate pending block; they're synonyms here), "earliest" is
+// always block 0, and a 0x-prefixed value is parsed as a number.
+func resolveBlockTag(tag string, chain *blockchain.Chain) (uint64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return chain.Head().Number, nil
+	case "earliest":
+		return 0, nil
+	}
+	if strings.HasPrefix(tag, "0x") {
+		return decodeQuantity(tag)
+	}
+	return 0, fmt.Errorf("unsupported block tag %q", tag)
+}
+
+// rpcBlock is the standard eth_getBlockByNumber/eth_getBlockByHash
+// JSON block object.
+type rpcBlock struct {
+	Number       string `json:"number"`
+	Hash         string `json:"hash"`
+	ParentHash   string `json:"parentHash"`
+	Timestamp    string `json:"timestamp"`
+	GasLimit     string `json:"gasLimit"`
+	GasUsed      string `json:"gasUsed"`
+	Transactions []any  `json:"transactions"`
+}
+
+// rpcTransaction is the standard eth_getBlockByNumber/
+// eth_getBlockByHash transaction object, returned per entry when the
+// caller asks for fullTx.
+type rpcTransaction struct {
+	Hash        string  `json:"hash"`
+	BlockNumber string  `json:"blockNumber"`
+	From        string  `json:"from"`
+	To          *string `json:"to"`
+	Value       string  `json:"value"`
+	Input       string  `json:"input"`
+	Gas         string  `json:"gas"`
+}
+
+// encodeBlock maps block to the standard JSON block object,
+// representing each transaction as just its hash, or as a full
+// rpcTransaction object when fullTx is set.
+func encodeBlock(block blockchain.Block, fullTx bool) rpcBlock {
+	txs := make([]any, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		if fullTx {
+			txs[i] = encodeTransaction(tx)
+		} else {
+			txs[i] = "0x" + hex.EncodeToString(tx.Hash[:])
+		}
+	}
+	return rpcBlock{
+		Number:       encodeQuantity(block.Number),
+		Hash:         "0x" + hex.EncodeToString(block.Hash[:]),
+		ParentHash:   "0x" + hex.EncodeToString(block.ParentHash[:]),
+		Timestamp:    encodeQuantity(block.Timestamp),
+		GasLimit:     encodeQuantity(block.GasLimit),
+		GasUsed:      encodeQuantity(block.GasUsed),
+		Transactions: txs,
+	}
+}
+
+func encodeTransaction(tx types.Transaction) rpcTransaction {
+	out := rpcTransaction{
+		Hash:        "0x" + hex.EncodeToString(tx.Hash[:]),
+		BlockNumber: encodeQuantity(tx.BlockNumber),
+		From:        "0x" + hex.EncodeToString(tx.From[:]),
+		Value:       encodeQuantity(tx.Value),
+		Input:       "0x" + hex.EncodeToString(tx.Data),
+		Gas:         encodeQuantity(tx.GasLimit),
+	}
+	if tx.To != nil {
+		to := "0x" + hex.EncodeToString(tx.To[:])
+		out.To = &to
+	}
+	return out
+}
+
+// parseSingleHashParam decodes the single [hash] positional param
+// eth_getTransactionByHash and eth_getTransactionReceipt both take.
+func parseSingleHashParam(params json.RawMessage) (string, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 1 {
+		return "", fmt.Errorf("expected a transaction hash")
+	}
+	var hashHex string
+	if err := json.Unmarshal(raw[0], &hashHex); err != nil {
+		return "", fmt.Errorf("invalid transaction hash: %w", err)
+	}
+	return hashHex, nil
+}
+
+// rpcReceipt is the standard eth_getTransactionReceipt JSON object.
+type rpcReceipt struct {
+	TransactionHash   string   `json:"transactionHash"`
+	BlockNumber       string   `json:"blockNumber"`
+	From              string   `json:"from"`
+	To                *string  `json:"to"`
+	Status            string   `json:"status"`
+	GasUsed           string   `json:"gasUsed"`
+	CumulativeGasUsed string   `json:"cumulativeGasUsed"`
+	Logs              []rpcLog `json:"logs"`
+	ContractAddress   *string  `json:"contractAddress"`
+}
+
+// rpcLog is a Log annotated with its index, as returned in a receipt.
+type rpcLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+	Index   string   `json:"logIndex"`
+}
+
+// encodeReceiptStatus renders a receipt's boolean status as the
+// QUANTITY "0x1"/"0x0" the JSON-RPC spec defines for it, rather than
+// a JSON boolean.
+func encodeReceiptStatus(status bool) string {
+	if status {
+		return "0x1"
+	}
+	return "0x0"
+}
+
+func encodeReceipt(r types.Receipt) rpcReceipt {
+	out := rpcReceipt{
+		TransactionHash:   "0x" + hex.EncodeToString(r.TransactionHash[:]),
+		BlockNumber:       encodeQuantity(r.BlockNumber),
+		From:              "0x" + hex.EncodeToString(r.From[:]),
+		Status:            encodeReceiptStatus(r.Status),
+		GasUsed:           encodeQuantity(r.GasUsed),
+		CumulativeGasUsed: encodeQuantity(r.CumulativeGasUsed),
+		Logs:              make([]rpcLog, len(r.Logs)),
+	}
+	if r.To != nil {
+		to := "0x" + hex.EncodeToString(r.To[:])
+		out.To = &to
+	}
+	if r.ContractAddress != nil {
+		addr := "0x" + hex.EncodeToString(r.ContractAddress[:])
+		out.ContractAddress = &addr
+	}
+	for i, log := range r.Logs {
+		topics := make([]string, len(log.Topics))
+		for j, t := range log.Topics {
+			topics[j] = "0x" + hex.EncodeToString(t[:])
+		}
+		out.Logs[i] = rpcLog{
+			Address: "0x" + hex.EncodeToString(log.Address[:]),
+			Topics:  topics,
+			Data:    "0x" + hex.EncodeToString(log.Data),
+			Index:   encodeQuantity(log.Index),
+		}
+	}
+	return out
+}
+
+// encodeQuantity hex-encodes n per the JSON-RPC QUANTITY convention:
+// no leading zeros, except the value 0 itself which encodes as "0x0".
+func encodeQuantity(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+// encodeQuantityBig is encodeQuantity for balances, which are
+// *big.Int since they can exceed a uint64 (100 ETH in wei already
+// does).
+func encodeQuantityBig(n *big.Int) string {
+	return "0x" + n.Text(16)
+}
+
+// revertError carries the revert output alongside the message so
+// ServeHTTP can surface both in the JSON-RPC error object.
+type revertError struct {
+	message string
+	data    string
+}
+
+func (e *revertError) Error() string { return e.message }
+
+// parseCallParams decodes the [callObject, blockTag] positional
+// params eth_call takes, defaulting blockTag to "latest" when the
+// second element is omitted.
+func parseCallParams(params json.RawMessage) (callObject, string, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) == 0 {
+		return callObject{}, "", fmt.Errorf("eth_call requires a transaction object")
+	}
+	var call callObject
+	if err := json.Unmarshal(raw[0], &call); err != nil {
+		return callObject{}, "", fmt.Errorf("invalid transaction object: %w", err)
+	}
+	blockTag := "latest"
+	if len(raw) > 1 {
+		if err := json.Unmarshal(raw[1], &blockTag); err != nil {
+			return callObject{}, "", fmt.Errorf("invalid block tag: %w", err)
+		}
+	}
+	return call, blockTag, nil
+}
+
+// validateBlockTag accepts "latest" and "pending" (chop has no
+// historical block store yet, so both resolve to current state) plus
+// any 0x-prefixed block number.
+func validateBlockTag(tag string) error {
+	switch tag {
+	case "latest", "pending", "":
+		return nil
+	}
+	if strings.HasPrefix(tag, "0x") {
+		return nil
+	}
+	return fmt.Errorf("unsupported block tag %q", tag)
+}
+
+// fillCallResult stamps the request fields guillotine doesn't always
+// echo back onto result, so the history.Entry chain.RecordCall produces
+// reflects what was actually asked for.
+func fillCallResult(result types.CallResult, ctx evm.ExecutionContext) types.CallResult {
+	result.From = ctx.From
+	result.To = ctx.To
+	result.Input = ctx.Input
+	result.Value = ctx.Value
+	result.GasLimit = ctx.GasLimit
+	return result
+}
+
+// applyPostState folds the code and storage a call touched back into
+// chain, mirroring genesis.ApplyToChain. Balance and nonce are left
+// alone: eth_sendTransaction already applies the value transfer and
+// nonce increment itself, so re-applying them from postState here would
+// double-count.
+func applyPostState(chain *blockchain.Chain, postState []types.PreStateEntry) {
+	for _, entry := range postState {
+		if len(entry.Account.Code) > 0 {
+			chain.SetCode(entry.Address, entry.Account.Code)
+		}
+		for _, slot := range entry.Account.Storage {
+			chain.SetStorage(entry.Address, slot.Key, slot.Value)
+		}
+	}
+}
+
+func callObjectToContext(call callObject, chain *blockchain.Chain) (evm.ExecutionContext, error) {
+	var from types.Address
+	if call.From != "" {
+		var err error
+		from, err = decodeAddress(call.From)
+		if err != nil {
+			return evm.ExecutionContext{}, fmt.Errorf("from: %w", err)
+		}
+	}
+	data, err := decodeHex(call.Data)
+	if err != nil {
+		return evm.ExecutionContext{}, fmt.Errorf("data: %w", err)
+	}
+	value, err := decodeQuantity(call.Value)
+	if err != nil {
+		return evm.ExecutionContext{}, fmt.Errorf("value: %w", err)
+	}
+	gas, err := decodeQuantity(call.Gas)
+	if err != nil {
+		return evm.ExecutionContext{}, fmt.Errorf("gas: %w", err)
+	}
+	if gas == 0 {
+		gas = 1_000_000
+	}
+
+	ctx := evm.ExecutionContext{
+		From:     from,
+		Input:    data,
+		Value:    value,
+		GasLimit: gas,
+		Fork:     bytecode.LatestFork,
+	}
+	if call.To != "" {
+		to, err := decodeAddress(call.To)
+		if err != nil {
+			return evm.ExecutionContext{}, fmt.Errorf("to: %w", err)
+		}
+		ctx.To = &to
+		ctx.Code = chain.CodeAt(to)
+	}
+	return ctx, nil
+}
+
+// executeReadOnly runs ctx via exec, preferring exec's
+// ExecuteCallReadOnly when it implements evm.ReadOnlyExecutor (e.g. a
+// persistent *evm.VMManager) so eth_call can never mutate balances,
+// nonces, or storage. A plain Executor already has no persistence to
+// discard, so ExecuteCall is equivalent there.
+func executeReadOnly(exec evm.Executor, ctx evm.ExecutionContext) (types.CallResult, error) {
+	if ro, ok := exec.(evm.ReadOnlyExecutor); ok {
+		return ro.ExecuteCallReadOnly(ctx)
+	}
+	return exec.ExecuteCall(ctx)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+func decodeAddress(s string) (types.Address, error) {
+	b, err := decodeHex(s)
+	if err != nil {
+		return types.Address{}, err
+	}
+	if len(b) != 20 {
+		return types.Address{}, fmt.Errorf("want 20 bytes, got %d", len(b))
+	}
+	var addr types.Address
+	copy(addr[:], b)
+	return addr, nil
+}
+
+func decodeQuantity(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+}
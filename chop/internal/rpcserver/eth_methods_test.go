@@ -0,0 +1,564 @@
+package rpcserver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// fakeExecutor is an evm.Executor that returns a canned result
+// without shelling out, so server tests don't need a real guillotine
+// binary on disk.
+type fakeExecutor struct {
+	result types.CallResult
+	err    error
+}
+
+func (f fakeExecutor) ExecuteCall(evm.ExecutionContext) (types.CallResult, error) {
+	return f.result, f.err
+}
+
+// readOnlyFakeExecutor additionally implements evm.ReadOnlyExecutor,
+// recording whether ExecuteCall or ExecuteCallReadOnly was the one
+// actually invoked.
+type readOnlyFakeExecutor struct {
+	fakeExecutor
+	readOnlyCalled *bool
+}
+
+func (f readOnlyFakeExecutor) ExecuteCallReadOnly(ctx evm.ExecutionContext) (types.CallResult, error) {
+	*f.readOnlyCalled = true
+	return f.ExecuteCall(ctx)
+}
+
+func postJSONRPC(t *testing.T, s *Server, body string) map[string]any {
+	t.Helper()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding response: %v (body=%s)", err, rec.Body.String())
+	}
+	return got
+}
+
+func TestEthCallReturnsHexOutput(t *testing.T) {
+	chain := blockchain.New()
+	exec := fakeExecutor{result: types.CallResult{Status: true, Output: []byte{0xca, 0xfe}}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x0000000000000000000000000000000000000001","data":"0x"},"latest"]}`)
+
+	if got["result"] != "0xcafe" {
+		t.Fatalf("got result %v, want 0xcafe", got["result"])
+	}
+}
+
+func TestEthCallPrefersReadOnlyExecuteWhenAvailable(t *testing.T) {
+	chain := blockchain.New()
+	var readOnlyCalled bool
+	exec := readOnlyFakeExecutor{
+		fakeExecutor:   fakeExecutor{result: types.CallResult{Status: true, Output: []byte{0xca, 0xfe}}},
+		readOnlyCalled: &readOnlyCalled,
+	}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x0000000000000000000000000000000000000001","data":"0x"},"latest"]}`)
+
+	if !readOnlyCalled {
+		t.Error("want eth_call to use ExecuteCallReadOnly when the executor implements evm.ReadOnlyExecutor")
+	}
+}
+
+func TestEthCallRevertDecodesReason(t *testing.T) {
+	chain := blockchain.New()
+	output := encodeRevert("insufficient balance")
+	exec := fakeExecutor{result: types.CallResult{Status: false, Output: output}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x0000000000000000000000000000000000000001"}]}`)
+
+	errObj, ok := got["error"].(map[string]any)
+	if !ok {
+		t.Fatalf("want error object, got %v", got)
+	}
+	if !strings.Contains(errObj["message"].(string), "insufficient balance") {
+		t.Errorf("got message %q, want it to contain the revert reason", errObj["message"])
+	}
+}
+
+func TestEthGetBalanceFundedAndUnknown(t *testing.T) {
+	chain := blockchain.New()
+	funded := types.Address{0x01}
+	chain.Accounts.SetBalance(funded, big.NewInt(1000))
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x0100000000000000000000000000000000000000","latest"]}`)
+	if got["result"] != "0x3e8" {
+		t.Errorf("got result %v, want 0x3e8", got["result"])
+	}
+
+	got = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x0000000000000000000000000000000000000099","latest"]}`)
+	if got["result"] != "0x0" {
+		t.Errorf("got result %v for unknown address, want 0x0", got["result"])
+	}
+}
+
+func TestEthGetTransactionCount(t *testing.T) {
+	chain := blockchain.New()
+	addr := types.Address{0x01}
+	chain.Accounts.IncrementNonce(addr)
+	chain.Accounts.IncrementNonce(addr)
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionCount","params":["0x0100000000000000000000000000000000000000","latest"]}`)
+	if got["result"] != "0x2" {
+		t.Errorf("got result %v, want 0x2", got["result"])
+	}
+}
+
+func TestEthGetCodeForDeployedAndUnknownAddress(t *testing.T) {
+	chain := blockchain.New()
+	deployed := types.Address{0x01}
+	chain.SetCode(deployed, []byte{0x60, 0x00, 0x60, 0x00})
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getCode","params":["0x0100000000000000000000000000000000000000","latest"]}`)
+	if got["result"] != "0x60006000" {
+		t.Errorf("got result %v, want 0x60006000", got["result"])
+	}
+
+	got = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getCode","params":["0x0000000000000000000000000000000000000099","latest"]}`)
+	if got["result"] != "0x" {
+		t.Errorf("got result %v for codeless address, want 0x", got["result"])
+	}
+}
+
+func TestEthGetStorageAtWrittenAndUnsetSlot(t *testing.T) {
+	chain := blockchain.New()
+	addr := types.Address{0x01}
+	slot := types.Hash{31: 0x01}
+	value := types.Hash{31: 0x2a}
+	chain.SetStorage(addr, slot, value)
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getStorageAt","params":["0x0100000000000000000000000000000000000000","0x1","latest"]}`)
+	want := "0x" + strings.Repeat("0", 62) + "2a"
+	if got["result"] != want {
+		t.Errorf("got result %v, want %v", got["result"], want)
+	}
+
+	got = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getStorageAt","params":["0x0100000000000000000000000000000000000000","0x2","latest"]}`)
+	wantZero := "0x" + strings.Repeat("0", 64)
+	if got["result"] != wantZero {
+		t.Errorf("got result %v for unset slot, want %v", got["result"], wantZero)
+	}
+}
+
+func TestEthGetBlockByNumberGenesis(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["earliest",false]}`)
+	block, ok := got["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("got result %v, want a block object", got["result"])
+	}
+	if block["number"] != "0x0" {
+		t.Errorf("got number %v, want 0x0", block["number"])
+	}
+	if block["parentHash"] != "0x"+strings.Repeat("0", 64) {
+		t.Errorf("got parentHash %v, want the zero hash", block["parentHash"])
+	}
+	if txs, ok := block["transactions"].([]any); !ok || len(txs) != 0 {
+		t.Errorf("got transactions %v, want an empty list", block["transactions"])
+	}
+}
+
+func TestEthGetBlockByNumberMinedBlockIncludesFullTx(t *testing.T) {
+	chain := blockchain.New()
+	from := types.Address{0x01}
+	chain.Accounts.SetBalance(from, big.NewInt(0))
+	mined := chain.AddBlock([]types.Transaction{{Hash: types.Hash{31: 0xaa}, From: from, GasUsed: 21000}})
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["latest",true]}`)
+	block, ok := got["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("got result %v, want a block object", got["result"])
+	}
+	if block["number"] != "0x1" {
+		t.Errorf("got number %v, want 0x1", block["number"])
+	}
+	wantParentHash := "0x" + hex.EncodeToString(mined.ParentHash[:])
+	if block["parentHash"] != wantParentHash {
+		t.Errorf("got parentHash %v, want %v", block["parentHash"], wantParentHash)
+	}
+	txs, ok := block["transactions"].([]any)
+	if !ok || len(txs) != 1 {
+		t.Fatalf("got transactions %v, want one full tx object", block["transactions"])
+	}
+	tx, ok := txs[0].(map[string]any)
+	if !ok {
+		t.Fatalf("got transaction %v, want an object since fullTx was true", txs[0])
+	}
+	if tx["hash"] != "0x"+strings.Repeat("0", 62)+"aa" {
+		t.Errorf("got tx hash %v", tx["hash"])
+	}
+}
+
+func TestEthGetBlockByHashFindsMinedBlock(t *testing.T) {
+	chain := blockchain.New()
+	mined := chain.AddBlock(nil)
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	hashHex := "0x" + hex.EncodeToString(mined.Hash[:])
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByHash","params":["`+hashHex+`",false]}`)
+	block, ok := got["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("got result %v, want a block object", got["result"])
+	}
+	if block["hash"] != hashHex {
+		t.Errorf("got hash %v, want %v", block["hash"], hashHex)
+	}
+
+	got = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByHash","params":["0x`+strings.Repeat("ff", 32)+`",false]}`)
+	if got["result"] != nil {
+		t.Errorf("got result %v for unknown hash, want nil", got["result"])
+	}
+}
+
+func TestEthSendTransactionTransfersValueAndMinesBlock(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	bob := types.Address{0x02}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+	exec := fakeExecutor{result: types.CallResult{Status: true}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","to":"0x0200000000000000000000000000000000000000","value":"0x64","gas":"0x5208"}]}`)
+
+	if _, ok := got["result"].(string); !ok {
+		t.Fatalf("want a tx hash result, got %v", got)
+	}
+	if got := chain.Accounts.GetAccount(alice).Balance; got.Cmp(big.NewInt(900)) != 0 {
+		t.Errorf("got sender balance %s, want 900", got)
+	}
+	if got := chain.Accounts.GetAccount(bob).Balance; got.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("got recipient balance %s, want 100", got)
+	}
+	if got := chain.Head().Number; got != 1 {
+		t.Errorf("got block height %d, want 1", got)
+	}
+}
+
+func TestEthSendTransactionDeploysCodeCallableAfterward(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	deployed := types.Address{0xde}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+	exec := fakeExecutor{result: types.CallResult{
+		Status:          true,
+		Output:          []byte{0x60, 0x00, 0x60, 0x00},
+		DeployedAddress: &deployed,
+	}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	sent := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","gas":"0x5208"}]}`)
+	if _, ok := sent["result"].(string); !ok {
+		t.Fatalf("want a tx hash result, got %v", sent)
+	}
+
+	gotCode := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getCode","params":["0x00000000000000000000000000000000000000de","latest"]}`)
+	if gotCode["result"] != "0x60006000" {
+		t.Errorf("got code %v after deploying via eth_sendTransaction, want 0x60006000", gotCode["result"])
+	}
+
+	callExec := fakeExecutor{result: types.CallResult{Status: true, Output: []byte{0xca, 0xfe}}}
+	RegisterEthMethods(s, chain, callExec)
+	gotCall := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x00000000000000000000000000000000000000de","data":"0x"},"latest"]}`)
+	if gotCall["result"] != "0xcafe" {
+		t.Errorf("got call result %v, want 0xcafe", gotCall["result"])
+	}
+}
+
+func TestEthSendTransactionFoldsPostStateStorage(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	touched := types.Address{0x02}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+	slotKey := types.Hash{0x01}
+	slotValue := types.Hash{0x02}
+	exec := fakeExecutor{result: types.CallResult{
+		Status: true,
+		PostState: []types.PreStateEntry{
+			{Address: touched, Account: types.AccountState{
+				Storage: []types.StorageSlot{{Key: slotKey, Value: slotValue}},
+			}},
+		},
+	}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","to":"0x0200000000000000000000000000000000000000","gas":"0x5208"}]}`)
+
+	if got := chain.StorageAt(touched, slotKey); got != slotValue {
+		t.Errorf("got storage %v, want postState slot folded back into the chain", got)
+	}
+}
+
+func TestEthSendTransactionRecordsHistoryAndContractStats(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	bob := types.Address{0x02}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+	exec := fakeExecutor{result: types.CallResult{Status: true, GasUsed: 21000}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","to":"0x0200000000000000000000000000000000000000","value":"0x64","gas":"0x5208"}]}`)
+
+	entries := chain.History.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d history entries, want 1", len(entries))
+	}
+	if entries[0].Call.To == nil || *entries[0].Call.To != bob {
+		t.Errorf("got recorded call to %v, want %v", entries[0].Call.To, bob)
+	}
+
+	stats := chain.Contracts.Sorted()
+	if len(stats) != 1 || stats[0].Calls != 1 {
+		t.Fatalf("got contract stats %+v, want one entry with Calls 1", stats)
+	}
+}
+
+func TestEthCallRecordsHistory(t *testing.T) {
+	chain := blockchain.New()
+	exec := fakeExecutor{result: types.CallResult{Status: true, Output: []byte{0xca, 0xfe}}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x0000000000000000000000000000000000000001","data":"0x"},"latest"]}`)
+
+	if entries := chain.History.All(); len(entries) != 1 {
+		t.Fatalf("got %d history entries, want 1", len(entries))
+	}
+}
+
+func TestEthGetTransactionByHashAndReceiptAfterSend(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	deployed := types.Address{0xde}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+	exec := fakeExecutor{result: types.CallResult{
+		Status:          true,
+		GasUsed:         54321,
+		DeployedAddress: &deployed,
+		Logs:            []types.Log{{Address: deployed, Topics: []types.Hash{{0x01}}}},
+	}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	sent := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","gas":"0x5208"}]}`)
+	txHash, ok := sent["result"].(string)
+	if !ok {
+		t.Fatalf("want a tx hash result, got %v", sent)
+	}
+
+	gotTx := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByHash","params":["`+txHash+`"]}`)
+	tx, ok := gotTx["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("got result %v, want a tx object", gotTx["result"])
+	}
+	if tx["hash"] != txHash {
+		t.Errorf("got tx hash %v, want %v", tx["hash"], txHash)
+	}
+	if tx["to"] != nil {
+		t.Errorf("got to %v, want nil for a contract creation", tx["to"])
+	}
+
+	gotReceipt := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["`+txHash+`"]}`)
+	receipt, ok := gotReceipt["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("got result %v, want a receipt object", gotReceipt["result"])
+	}
+	if receipt["status"] != "0x1" {
+		t.Errorf("got status %v, want 0x1", receipt["status"])
+	}
+	if receipt["gasUsed"] != "0xd431" {
+		t.Errorf("got gasUsed %v, want 0xd431 (54321)", receipt["gasUsed"])
+	}
+	if receipt["contractAddress"] != "0x"+hex.EncodeToString(deployed[:]) {
+		t.Errorf("got contractAddress %v, want the deployed address", receipt["contractAddress"])
+	}
+	logs, ok := receipt["logs"].([]any)
+	if !ok || len(logs) != 1 {
+		t.Fatalf("got logs %v, want one log", receipt["logs"])
+	}
+}
+
+func TestEthGetTransactionByHashAndReceiptUnknownHashReturnsNull(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	unknown := "0x" + strings.Repeat("ff", 32)
+	gotTx := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionByHash","params":["`+unknown+`"]}`)
+	if gotTx["result"] != nil {
+		t.Errorf("got result %v for unknown tx hash, want nil", gotTx["result"])
+	}
+	if _, hasError := gotTx["error"]; hasError {
+		t.Errorf("got error %v, want a nil result instead of an error", gotTx["error"])
+	}
+
+	gotReceipt := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["`+unknown+`"]}`)
+	if gotReceipt["result"] != nil {
+		t.Errorf("got result %v for unknown tx hash, want nil", gotReceipt["result"])
+	}
+	if _, hasError := gotReceipt["error"]; hasError {
+		t.Errorf("got error %v, want a nil result instead of an error", gotReceipt["error"])
+	}
+}
+
+func TestEthSendTransactionRejectsInsufficientBalance(t *testing.T) {
+	chain := blockchain.New()
+	exec := fakeExecutor{result: types.CallResult{Status: true}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","to":"0x0200000000000000000000000000000000000000","value":"0x64"}]}`)
+
+	if _, ok := got["error"]; !ok {
+		t.Fatalf("want an error for insufficient balance, got %v", got)
+	}
+	if got := chain.Head().Number; got != 0 {
+		t.Errorf("got block height %d, want no block mined", got)
+	}
+}
+
+func TestEthSendTransactionConcurrentSendsDontDoubleSpend(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	chain.Accounts.SetBalance(alice, big.NewInt(100))
+	exec := fakeExecutor{result: types.CallResult{Status: true}}
+	s := New()
+	RegisterEthMethods(s, chain, exec)
+
+	const attempts = 20
+	var wg sync.WaitGroup
+	var succeeded int64
+	var mu sync.Mutex
+	wg.Add(attempts)
+	for i := 0; i < attempts; i++ {
+		go func() {
+			defer wg.Done()
+			got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_sendTransaction","params":[{"from":"0x0100000000000000000000000000000000000000","to":"0x0200000000000000000000000000000000000000","value":"0x64"}]}`)
+			if _, ok := got["result"]; ok {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("got %d successful sends of a balance that only covers one, want exactly 1 (ApplyStateDiff should serialize the balance check and deduction)", succeeded)
+	}
+	if got := chain.Accounts.GetAccount(alice).Balance; got.Sign() < 0 {
+		t.Errorf("got negative sender balance %s after concurrent sends", got)
+	}
+}
+
+func TestEthChainIdAndNetVersion(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	if err := s.SetChainID(31337); err != nil {
+		t.Fatal(err)
+	}
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+	if got["result"] != "0x7a69" {
+		t.Errorf("got eth_chainId result %v, want 0x7a69", got["result"])
+	}
+
+	got = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"net_version","params":[]}`)
+	if got["result"] != "31337" {
+		t.Errorf("got net_version result %v, want decimal string 31337", got["result"])
+	}
+}
+
+func TestEthBlockNumberTracksAddBlock(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	if got["result"] != "0x0" {
+		t.Fatalf("got eth_blockNumber result %v, want 0x0 before any block is mined", got["result"])
+	}
+
+	chain.AddBlock(nil)
+
+	got = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber","params":[]}`)
+	if got["result"] != "0x1" {
+		t.Fatalf("got eth_blockNumber result %v, want 0x1 after mining a block", got["result"])
+	}
+}
+
+func TestEthGasPriceMatchesConfiguredValue(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	s.SetGasPrice(42)
+	RegisterEthMethods(s, chain, fakeExecutor{})
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"eth_gasPrice","params":[]}`)
+	if got["result"] != "0x2a" {
+		t.Fatalf("got eth_gasPrice result %v, want 0x2a", got["result"])
+	}
+}
+
+func TestSetChainIDRejectsZero(t *testing.T) {
+	s := New()
+	if err := s.SetChainID(0); err == nil {
+		t.Error("want an error setting chain ID to 0")
+	}
+}
+
+// encodeRevert builds the standard Error(string) ABI encoding a
+// Solidity revert("reason") produces, for use as test fixture output.
+func encodeRevert(reason string) []byte {
+	out := []byte{0x08, 0xc3, 0x79, 0xa0}
+	offset := make([]byte, 32)
+	offset[31] = 32
+	out = append(out, offset...)
+	length := make([]byte, 32)
+	length[31] = byte(len(reason))
+	out = append(out, length...)
+	padded := make([]byte, (len(reason)+31)/32*32)
+	copy(padded, reason)
+	return append(out, padded...)
+}
@@ -0,0 +1,183 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/types"
+)
+
+// RegisterEvmMethods adds the Hardhat-style evm_*-namespaced cheat
+// methods, which mutate chain state directly rather than through a
+// signed transaction. They're gated behind --enable-cheats (see
+// cmd/serve.go) since they let any RPC caller rewrite balances, code,
+// and storage outright.
+func RegisterEvmMethods(s *Server, chain *blockchain.Chain) {
+	s.Register("evm_setBalance", func(params json.RawMessage) (any, error) {
+		addr, balance, err := parseAddressAndBigQuantity(params)
+		if err != nil {
+			return nil, err
+		}
+		chain.Accounts.SetBalance(addr, balance)
+		return true, nil
+	})
+
+	s.Register("evm_setCode", func(params json.RawMessage) (any, error) {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 2 {
+			return nil, fmt.Errorf("evm_setCode requires [address, code]")
+		}
+		var addrHex, codeHex string
+		if err := json.Unmarshal(raw[0], &addrHex); err != nil {
+			return nil, fmt.Errorf("invalid address: %w", err)
+		}
+		addr, err := decodeAddress(addrHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %w", err)
+		}
+		if err := json.Unmarshal(raw[1], &codeHex); err != nil {
+			return nil, fmt.Errorf("invalid code: %w", err)
+		}
+		code, err := decodeHex(codeHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code: %w", err)
+		}
+		chain.SetCode(addr, code)
+		return true, nil
+	})
+
+	s.Register("evm_setStorageAt", func(params json.RawMessage) (any, error) {
+		addr, slot, value, err := parseAddressSlotAndValue(params)
+		if err != nil {
+			return nil, err
+		}
+		chain.SetStorage(addr, slot, value)
+		return true, nil
+	})
+
+	s.Register("evm_snapshot", func(_ json.RawMessage) (any, error) {
+		return chain.Snapshot(), nil
+	})
+
+	s.Register("evm_revert", func(params json.RawMessage) (any, error) {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 1 {
+			return nil, fmt.Errorf("evm_revert requires [snapshotId]")
+		}
+		var id string
+		if err := json.Unmarshal(raw[0], &id); err != nil {
+			return nil, fmt.Errorf("invalid snapshot id: %w", err)
+		}
+		return chain.Revert(id) == nil, nil
+	})
+
+	s.Register("evm_mine", func(params json.RawMessage) (any, error) {
+		timestamp, err := parseOptionalTimestamp(params)
+		if err != nil {
+			return nil, err
+		}
+		if timestamp != nil {
+			chain.MineBlockAt(*timestamp)
+		} else {
+			chain.MineBlock()
+		}
+		return true, nil
+	})
+}
+
+// parseOptionalTimestamp decodes evm_mine's optional [timestamp]
+// param, returning nil when it's omitted so the caller mines with the
+// wall clock instead of a forced time.
+func parseOptionalTimestamp(params json.RawMessage) (*uint64, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 1 {
+		return nil, nil
+	}
+	var timestampHex string
+	if err := json.Unmarshal(raw[0], &timestampHex); err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	timestamp, err := decodeQuantity(timestampHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	return &timestamp, nil
+}
+
+// parseAddressAndBigQuantity decodes the [address, quantity] positional
+// params evm_setBalance takes. The quantity is parsed as a *big.Int,
+// unlike decodeQuantity's uint64, since balances routinely exceed it.
+func parseAddressAndBigQuantity(params json.RawMessage) (types.Address, *big.Int, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 2 {
+		return types.Address{}, nil, fmt.Errorf("expected [address, balance]")
+	}
+	var addrHex string
+	if err := json.Unmarshal(raw[0], &addrHex); err != nil {
+		return types.Address{}, nil, fmt.Errorf("invalid address: %w", err)
+	}
+	addr, err := decodeAddress(addrHex)
+	if err != nil {
+		return types.Address{}, nil, fmt.Errorf("invalid address: %w", err)
+	}
+	var balanceHex string
+	if err := json.Unmarshal(raw[1], &balanceHex); err != nil {
+		return types.Address{}, nil, fmt.Errorf("invalid balance: %w", err)
+	}
+	balance, err := decodeQuantityBig(balanceHex)
+	if err != nil {
+		return types.Address{}, nil, fmt.Errorf("invalid balance: %w", err)
+	}
+	return addr, balance, nil
+}
+
+// parseAddressSlotAndValue decodes the [address, slot, value]
+// positional params evm_setStorageAt takes.
+func parseAddressSlotAndValue(params json.RawMessage) (types.Address, types.Hash, types.Hash, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil || len(raw) < 3 {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("expected [address, slot, value]")
+	}
+	var addrHex string
+	if err := json.Unmarshal(raw[0], &addrHex); err != nil {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("invalid address: %w", err)
+	}
+	addr, err := decodeAddress(addrHex)
+	if err != nil {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("invalid address: %w", err)
+	}
+	var slotHex string
+	if err := json.Unmarshal(raw[1], &slotHex); err != nil {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("invalid slot: %w", err)
+	}
+	slot, err := decodeHash(slotHex)
+	if err != nil {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("invalid slot: %w", err)
+	}
+	var valueHex string
+	if err := json.Unmarshal(raw[2], &valueHex); err != nil {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("invalid value: %w", err)
+	}
+	value, err := decodeHash(valueHex)
+	if err != nil {
+		return types.Address{}, types.Hash{}, types.Hash{}, fmt.Errorf("invalid value: %w", err)
+	}
+	return addr, slot, value, nil
+}
+
+// decodeQuantityBig is decodeQuantity for values that can exceed a
+// uint64, such as a balance in wei.
+func decodeQuantityBig(s string) (*big.Int, error) {
+	trimmed := strings.TrimPrefix(s, "0x")
+	if trimmed == "" {
+		return big.NewInt(0), nil
+	}
+	n, ok := new(big.Int).SetString(trimmed, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex quantity %q", s)
+	}
+	return n, nil
+}
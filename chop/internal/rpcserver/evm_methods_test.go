@@ -0,0 +1,164 @@
+package rpcserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evmts/chop/internal/blockchain"
+)
+
+func TestEvmSetBalanceThenEthGetBalance(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterEvmMethods(s, chain)
+
+	setGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_setBalance","params":["0x0100000000000000000000000000000000000000","0x3e8"]}`)
+	if setGot["result"] != true {
+		t.Fatalf("got evm_setBalance result %v, want true", setGot["result"])
+	}
+
+	getGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"eth_getBalance","params":["0x0100000000000000000000000000000000000000","latest"]}`)
+	if getGot["result"] != "0x3e8" {
+		t.Fatalf("got eth_getBalance result %v, want 0x3e8", getGot["result"])
+	}
+}
+
+func TestEvmSetCodeThenEthGetCode(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterEvmMethods(s, chain)
+
+	setGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_setCode","params":["0x0100000000000000000000000000000000000000","0x6001"]}`)
+	if setGot["result"] != true {
+		t.Fatalf("got evm_setCode result %v, want true", setGot["result"])
+	}
+
+	getGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"eth_getCode","params":["0x0100000000000000000000000000000000000000","latest"]}`)
+	if getGot["result"] != "0x6001" {
+		t.Fatalf("got eth_getCode result %v, want 0x6001", getGot["result"])
+	}
+}
+
+func TestEvmSetStorageAtThenEthGetStorageAt(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterEvmMethods(s, chain)
+
+	setGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_setStorageAt","params":["0x0100000000000000000000000000000000000000","0x0","0x2a"]}`)
+	if setGot["result"] != true {
+		t.Fatalf("got evm_setStorageAt result %v, want true", setGot["result"])
+	}
+
+	getGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"eth_getStorageAt","params":["0x0100000000000000000000000000000000000000","0x0","latest"]}`)
+	want := "0x000000000000000000000000000000000000000000000000000000000000002a"
+	if getGot["result"] != want {
+		t.Fatalf("got eth_getStorageAt result %v, want %v", getGot["result"], want)
+	}
+}
+
+func TestEvmSnapshotMineRevertRestoresBlockNumber(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterEvmMethods(s, chain)
+
+	snapGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_snapshot","params":[]}`)
+	snapshotID, ok := snapGot["result"].(string)
+	if !ok || snapshotID == "" {
+		t.Fatalf("got evm_snapshot result %v, want a non-empty snapshot id", snapGot["result"])
+	}
+
+	mineGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"evm_mine","params":[]}`)
+	if mineGot["result"] != true {
+		t.Fatalf("got evm_mine result %v, want true", mineGot["result"])
+	}
+
+	blockNumGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber","params":[]}`)
+	if blockNumGot["result"] != "0x1" {
+		t.Fatalf("got eth_blockNumber result %v, want 0x1", blockNumGot["result"])
+	}
+
+	revertGot := postJSONRPC(t, s, fmt.Sprintf(`{"jsonrpc":"2.0","id":4,"method":"evm_revert","params":[%q]}`, snapshotID))
+	if revertGot["result"] != true {
+		t.Fatalf("got evm_revert result %v, want true", revertGot["result"])
+	}
+
+	blockNumGot = postJSONRPC(t, s, `{"jsonrpc":"2.0","id":5,"method":"eth_blockNumber","params":[]}`)
+	if blockNumGot["result"] != "0x0" {
+		t.Fatalf("got eth_blockNumber result %v after revert, want 0x0", blockNumGot["result"])
+	}
+}
+
+func TestEvmSnapshotRevertRestoresStorage(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterEvmMethods(s, chain)
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_setStorageAt","params":["0x0100000000000000000000000000000000000000","0x0","0x2a"]}`)
+
+	snapGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"evm_snapshot","params":[]}`)
+	snapshotID, ok := snapGot["result"].(string)
+	if !ok || snapshotID == "" {
+		t.Fatalf("got evm_snapshot result %v, want a non-empty snapshot id", snapGot["result"])
+	}
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":3,"method":"evm_setStorageAt","params":["0x0100000000000000000000000000000000000000","0x0","0x63"]}`)
+
+	revertGot := postJSONRPC(t, s, fmt.Sprintf(`{"jsonrpc":"2.0","id":4,"method":"evm_revert","params":[%q]}`, snapshotID))
+	if revertGot["result"] != true {
+		t.Fatalf("got evm_revert result %v, want true", revertGot["result"])
+	}
+
+	getGot := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":5,"method":"eth_getStorageAt","params":["0x0100000000000000000000000000000000000000","0x0","latest"]}`)
+	want := "0x000000000000000000000000000000000000000000000000000000000000002a"
+	if getGot["result"] != want {
+		t.Fatalf("got eth_getStorageAt result %v after revert, want %v", getGot["result"], want)
+	}
+}
+
+func TestEvmRevertOfStaleSnapshotIDReturnsFalse(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEvmMethods(s, chain)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_revert","params":["0x999"]}`)
+	if got["result"] != false {
+		t.Fatalf("got evm_revert result %v, want false for a stale snapshot id", got["result"])
+	}
+	if _, hasError := got["error"]; hasError {
+		t.Errorf("want no error for a stale snapshot id, got %v", got["error"])
+	}
+}
+
+func TestEvmMineWithTimestampSetsBlockTimestamp(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterEvmMethods(s, chain)
+
+	postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_mine","params":["0x64"]}`)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":2,"method":"eth_getBlockByNumber","params":["0x1",false]}`)
+	block, ok := got["result"].(map[string]any)
+	if !ok {
+		t.Fatalf("got result %v, want a block object", got["result"])
+	}
+	if block["timestamp"] != "0x64" {
+		t.Errorf("got timestamp %v, want 0x64", block["timestamp"])
+	}
+}
+
+func TestEvmSetBalanceRejectsMalformedAddress(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEvmMethods(s, chain)
+
+	got := postJSONRPC(t, s, `{"jsonrpc":"2.0","id":1,"method":"evm_setBalance","params":["not-an-address","0x1"]}`)
+	if _, hasError := got["error"]; !hasError {
+		t.Error("want an error for a malformed address")
+	}
+}
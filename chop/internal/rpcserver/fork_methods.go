@@ -0,0 +1,21 @@
+package rpcserver
+
+import (
+	"encoding/json"
+
+	"github.com/evmts/chop/internal/fork"
+)
+
+// RegisterForkMethods adds the chop_-namespaced admin methods for
+// inspecting and managing a session's fork cache. Only called when
+// the session was started with forking enabled.
+func RegisterForkMethods(s *Server, forker *fork.Forker) {
+	s.Register("chop_forkStats", func(_ json.RawMessage) (any, error) {
+		return forker.Stats(), nil
+	})
+
+	s.Register("chop_clearForkCache", func(_ json.RawMessage) (any, error) {
+		forker.ClearCache()
+		return true, nil
+	})
+}
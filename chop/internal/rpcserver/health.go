@@ -0,0 +1,18 @@
+package rpcserver
+
+import "github.com/evmts/chop/internal/blockchain"
+
+// RegisterHealthCheck wires GET /health and GET /ready to chain,
+// reporting its current block height and funded account count, and
+// marks the server ready immediately since chain and accounts are
+// already set up by the time `chop serve` calls this.
+func RegisterHealthCheck(s *Server, chain *blockchain.Chain) {
+	s.SetHealthFunc(func() (uint64, int) {
+		accounts := 0
+		if chain.Accounts != nil {
+			accounts = len(chain.Accounts.GetAllAccounts())
+		}
+		return chain.Head().Number, accounts
+	})
+	s.SetReady(true)
+}
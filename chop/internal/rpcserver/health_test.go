@@ -0,0 +1,52 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evmts/chop/internal/blockchain"
+)
+
+func TestHealthEndpointReportsBlockHeight(t *testing.T) {
+	chain := blockchain.New()
+	chain.AddBlock(nil)
+	chain.AddBlock(nil)
+	s := New()
+	RegisterHealthCheck(s, chain)
+
+	req := httptest.NewRequest("GET", "/health", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("got status %d, want 200", rec.Code)
+	}
+	var got healthBody
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding /health response: %v (body=%s)", err, rec.Body.String())
+	}
+	if got.BlockHeight != 2 {
+		t.Errorf("got blockHeight %d, want 2", got.BlockHeight)
+	}
+}
+
+func TestReadyEndpointReturns503UntilRegistered(t *testing.T) {
+	s := New()
+
+	req := httptest.NewRequest("GET", "/ready", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != 503 {
+		t.Fatalf("got status %d, want 503 before RegisterHealthCheck", rec.Code)
+	}
+
+	RegisterHealthCheck(s, blockchain.New())
+
+	req = httptest.NewRequest("GET", "/ready", nil)
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("got status %d, want 200 after RegisterHealthCheck", rec.Code)
+	}
+}
@@ -0,0 +1,73 @@
+package rpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandledRequestProducesLogEntryWithLatency(t *testing.T) {
+	s := New()
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) {
+		return "pong", nil
+	})
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	logs := s.GetRecentLogs()
+	if len(logs) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(logs))
+	}
+	if logs[0].Method != "chop_ping" {
+		t.Errorf("got method %q, want chop_ping", logs[0].Method)
+	}
+	if logs[0].Status != "ok" {
+		t.Errorf("got status %q, want ok", logs[0].Status)
+	}
+	if logs[0].Latency <= 0 {
+		t.Error("want non-zero latency")
+	}
+}
+
+func TestSetLogSizeTrimsOldestEntries(t *testing.T) {
+	s := New()
+	s.SetLogSize(1)
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+		s.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if got := len(s.GetRecentLogs()); got != 1 {
+		t.Errorf("got %d log entries, want 1 after SetLogSize(1)", got)
+	}
+}
+
+func TestJSONLogFormatEmitsParsableLineWithMethod(t *testing.T) {
+	s := New()
+	var buf bytes.Buffer
+	s.SetLogOutput(&buf)
+	s.SetLogFormat("json")
+	s.SetVerbose(true)
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	s.ServeHTTP(httptest.NewRecorder(), req)
+
+	line := strings.TrimSpace(buf.String())
+	if line == "" {
+		t.Fatal("want a log line to be written")
+	}
+	var parsed logLine
+	if err := json.Unmarshal([]byte(line), &parsed); err != nil {
+		t.Fatalf("log line did not parse as JSON: %v\nline: %s", err, line)
+	}
+	if parsed.Method != "chop_ping" {
+		t.Errorf("got method %q, want chop_ping", parsed.Method)
+	}
+}
@@ -0,0 +1,117 @@
+package rpcserver
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxRateLimitBuckets bounds s.rateLimitBuckets so a client rotating
+// source IPs/ports (or simply many distinct long-lived clients) can't
+// grow it without limit; once full, allowRequest evicts the
+// least-recently-used bucket to make room for a new one.
+const maxRateLimitBuckets = 10_000
+
+// tokenBucket is a classic token-bucket limiter: it holds up to
+// capacity tokens, refilling at refillPerSec tokens/second, and each
+// allowed request consumes one.
+type tokenBucket struct {
+	mu           sync.Mutex
+	tokens       float64
+	capacity     float64
+	refillPerSec float64
+	last         time.Time
+}
+
+func newTokenBucket(ratePerSec int) *tokenBucket {
+	return &tokenBucket{
+		tokens:       float64(ratePerSec),
+		capacity:     float64(ratePerSec),
+		refillPerSec: float64(ratePerSec),
+		last:         time.Now(),
+	}
+}
+
+func (b *tokenBucket) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.refillPerSec
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// lastUsed returns the last time allow() ran for this bucket, used to
+// pick an eviction candidate when s.rateLimitBuckets is full.
+func (b *tokenBucket) lastUsed() time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.last
+}
+
+// SetRateLimit caps requests per second per client IP (keyed by
+// RemoteAddr), enforced by ServeHTTP in front of the JSON-RPC and
+// WebSocket handlers. ratePerSec <= 0 disables the limit, the default.
+func (s *Server) SetRateLimit(ratePerSec int) {
+	s.rateLimitMu.Lock()
+	defer s.rateLimitMu.Unlock()
+	s.rateLimit = ratePerSec
+	s.rateLimitBuckets = make(map[string]*tokenBucket)
+}
+
+// allowRequest reports whether remoteAddr may proceed, consuming a
+// token from its bucket if so. Always true when no rate limit is set.
+func (s *Server) allowRequest(remoteAddr string) bool {
+	s.rateLimitMu.Lock()
+	rate := s.rateLimit
+	if rate <= 0 {
+		s.rateLimitMu.Unlock()
+		return true
+	}
+	key := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		key = host
+	}
+	bucket, ok := s.rateLimitBuckets[key]
+	if !ok {
+		if len(s.rateLimitBuckets) >= maxRateLimitBuckets {
+			s.evictOldestBucketLocked()
+		}
+		bucket = newTokenBucket(rate)
+		s.rateLimitBuckets[key] = bucket
+	}
+	s.rateLimitMu.Unlock()
+
+	return bucket.allow()
+}
+
+// evictOldestBucketLocked removes the least-recently-used bucket from
+// s.rateLimitBuckets. Callers must hold s.rateLimitMu.
+func (s *Server) evictOldestBucketLocked() {
+	var oldestKey string
+	var oldestTime time.Time
+	for key, bucket := range s.rateLimitBuckets {
+		used := bucket.lastUsed()
+		if oldestKey == "" || used.Before(oldestTime) {
+			oldestKey, oldestTime = key, used
+		}
+	}
+	delete(s.rateLimitBuckets, oldestKey)
+}
+
+// writeRateLimitError writes the HTTP 429 + JSON-RPC error response
+// returned to a client that has exceeded its rate limit.
+func writeRateLimitError(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusTooManyRequests)
+	writeJSON(w, response{JSONRPC: "2.0", Error: &rpcError{Code: -32005, Message: "rate limit exceeded"}})
+}
@@ -0,0 +1,84 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRateLimitReturns429WhenExceeded(t *testing.T) {
+	s := New()
+	s.SetRateLimit(2)
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	var got429 bool
+	for i := 0; i < 10; i++ {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+		req.RemoteAddr = "203.0.113.1:12345"
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		if rec.Code == 429 {
+			got429 = true
+			break
+		}
+	}
+	if !got429 {
+		t.Error("want at least one request to be rejected with 429 once the rate limit is exceeded")
+	}
+}
+
+func TestRateLimitDisabledByDefault(t *testing.T) {
+	s := New()
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	for i := 0; i < 20; i++ {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+		req.RemoteAddr = "203.0.113.2:12345"
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		if rec.Code != 200 {
+			t.Fatalf("got status %d on request %d, want 200 with no rate limit configured", rec.Code, i)
+		}
+	}
+}
+
+func TestAllowRequestEvictsOldestBucketOnceFull(t *testing.T) {
+	s := New()
+	s.SetRateLimit(1)
+
+	for i := 0; i < maxRateLimitBuckets; i++ {
+		s.allowRequest(fmt.Sprintf("10.0.%d.%d:1", i/256, i%256))
+	}
+	if got := len(s.rateLimitBuckets); got != maxRateLimitBuckets {
+		t.Fatalf("got %d buckets after filling to capacity, want %d", got, maxRateLimitBuckets)
+	}
+
+	s.allowRequest("198.51.100.1:1")
+	if got := len(s.rateLimitBuckets); got != maxRateLimitBuckets {
+		t.Errorf("got %d buckets after going over capacity, want eviction to keep it at %d", got, maxRateLimitBuckets)
+	}
+}
+
+func TestRateLimitIsPerClientIP(t *testing.T) {
+	s := New()
+	s.SetRateLimit(1)
+	s.Register("chop_ping", func(_ json.RawMessage) (any, error) { return "pong", nil })
+
+	req1 := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	req1.RemoteAddr = "203.0.113.3:1"
+	rec1 := httptest.NewRecorder()
+	s.ServeHTTP(rec1, req1)
+	if rec1.Code != 200 {
+		t.Fatalf("got status %d for first client's first request, want 200", rec1.Code)
+	}
+
+	req2 := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_ping","params":[]}`))
+	req2.RemoteAddr = "203.0.113.4:1"
+	rec2 := httptest.NewRecorder()
+	s.ServeHTTP(rec2, req2)
+	if rec2.Code != 200 {
+		t.Fatalf("got status %d for a different client's first request, want 200 (separate bucket)", rec2.Code)
+	}
+}
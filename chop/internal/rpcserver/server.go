@@ -0,0 +1,612 @@
+// Package rpcserver implements the JSON-RPC 2.0 server chop exposes
+// over HTTP for both the standard eth_* namespace and chop's own
+// chop_*-prefixed introspection methods.
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Handler answers a single JSON-RPC method call with the raw params
+// and returns the result to be marshaled back to the caller, or an
+// error.
+type Handler func(params json.RawMessage) (any, error)
+
+// Server is a JSON-RPC 2.0 server backed by a method registry. It
+// answers both plain HTTP JSON-RPC requests and, once a connection is
+// upgraded, WebSocket JSON-RPC requests plus eth_subscribe push
+// notifications.
+type Server struct {
+	methods map[string]Handler
+
+	subsMu    sync.Mutex
+	subs      map[string]*subscription
+	nextSubID uint64
+
+	// cfgMu guards allowedOrigins, maxBatchSize, chainID, and gasPrice,
+	// which can be changed after Serve starts (e.g. a SIGHUP config
+	// reload, see cmd/serve.go) while ServeHTTP and method handlers are
+	// already reading them from request-handling goroutines.
+	cfgMu          sync.Mutex
+	allowedOrigins []string
+	maxBatchSize   int
+	chainID        uint64
+	gasPrice       uint64
+
+	logMu     sync.Mutex
+	verbose   bool
+	logSize   int
+	logs      []LogEntry
+	logFormat string
+	logOutput io.Writer
+
+	startedAt time.Time
+
+	healthMu sync.Mutex
+	ready    bool
+	healthFn func() (blockHeight uint64, accounts int)
+
+	rateLimitMu      sync.Mutex
+	rateLimit        int
+	rateLimitBuckets map[string]*tokenBucket
+
+	httpServer *http.Server
+	inFlightWG sync.WaitGroup
+
+	authToken string
+}
+
+// defaultLogSize is how many recent requests GetRecentLogs retains
+// when SetLogSize hasn't been called.
+const defaultLogSize = 100
+
+// defaultMaxBatchSize is how many requests a single JSON-RPC batch
+// may contain when SetMaxBatchSize hasn't been called.
+const defaultMaxBatchSize = 100
+
+// defaultGasPrice is the constant eth_gasPrice reports when
+// SetGasPrice hasn't been called: 1 gwei.
+const defaultGasPrice = 1_000_000_000
+
+// defaultLogFormat is how log lines are rendered when SetLogFormat
+// hasn't been called: human-readable text rather than JSON.
+const defaultLogFormat = "text"
+
+// New returns a Server with no methods registered and every origin
+// allowed.
+func New() *Server {
+	return &Server{
+		methods:          make(map[string]Handler),
+		subs:             make(map[string]*subscription),
+		allowedOrigins:   []string{"*"},
+		logSize:          defaultLogSize,
+		maxBatchSize:     defaultMaxBatchSize,
+		chainID:          1,
+		gasPrice:         defaultGasPrice,
+		logFormat:        defaultLogFormat,
+		logOutput:        os.Stdout,
+		startedAt:        time.Now(),
+		rateLimitBuckets: make(map[string]*tokenBucket),
+	}
+}
+
+// SetChainID sets the chain ID eth_chainId and net_version report.
+// id must be non-zero.
+func (s *Server) SetChainID(id uint64) error {
+	if id == 0 {
+		return fmt.Errorf("chain ID must be non-zero")
+	}
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.chainID = id
+	return nil
+}
+
+// ChainID returns the chain ID eth_chainId and net_version report.
+func (s *Server) ChainID() uint64 {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	return s.chainID
+}
+
+// LogEntry records one handled JSON-RPC request for the TUI's live
+// server activity view. It deliberately carries only the params'
+// size rather than their content, since params to methods like
+// eth_sendRawTransaction or chop's dev-only account import can
+// contain private keys.
+type LogEntry struct {
+	Method     string        `json:"method"`
+	ParamsSize int           `json:"paramsSize"`
+	Status     string        `json:"status"` // "ok" or "error"
+	Latency    time.Duration `json:"latency"`
+}
+
+// SetVerbose turns request/response logging on or off. Every handled
+// request is appended to the in-memory log buffer either way; while
+// verbose is on, each one is also written as a line to the log output
+// (stdout unless SetLogOutput changed it).
+func (s *Server) SetVerbose(verbose bool) {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	s.verbose = verbose
+}
+
+// SetLogSize caps the number of LogEntry values GetRecentLogs
+// retains, trimming the oldest entries once exceeded.
+func (s *Server) SetLogSize(n int) {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	s.logSize = n
+	if len(s.logs) > n {
+		s.logs = s.logs[len(s.logs)-n:]
+	}
+}
+
+// GetRecentLogs returns the most recent handled requests, oldest
+// first, up to the configured log size.
+func (s *Server) GetRecentLogs() []LogEntry {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	out := make([]LogEntry, len(s.logs))
+	copy(out, s.logs)
+	return out
+}
+
+// logRequest appends entry to the rolling log buffer and, if verbose
+// logging is on, writes it to the log output in the configured format.
+func (s *Server) logRequest(entry LogEntry) {
+	s.logMu.Lock()
+	verbose := s.verbose
+	s.logs = append(s.logs, entry)
+	if len(s.logs) > s.logSize {
+		s.logs = s.logs[len(s.logs)-s.logSize:]
+	}
+	s.logMu.Unlock()
+
+	if verbose {
+		level := "info"
+		if entry.Status == "error" {
+			level = "error"
+		}
+		s.writeLogLine(level, "", entry.Method, entry.Latency)
+	}
+}
+
+// SetLogFormat selects how log lines are rendered: "text" (the
+// default, human-readable) or "json" (one JSON object per line, for
+// log aggregators).
+func (s *Server) SetLogFormat(format string) {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	s.logFormat = format
+}
+
+// SetLogOutput redirects where log lines are written; it defaults to
+// os.Stdout. Mainly useful for tests capturing log output.
+func (s *Server) SetLogOutput(w io.Writer) {
+	s.logMu.Lock()
+	defer s.logMu.Unlock()
+	s.logOutput = w
+}
+
+// logLine is one server event, serialized as a single JSON object per
+// line when the server's log format is "json".
+type logLine struct {
+	Time      string  `json:"ts"`
+	Level     string  `json:"level"`
+	Msg       string  `json:"msg,omitempty"`
+	Method    string  `json:"method,omitempty"`
+	LatencyMs float64 `json:"latency_ms,omitempty"`
+}
+
+// writeLogLine renders one event (a lifecycle event when msg is set, a
+// handled request when method is set) and writes it to the server's
+// log output.
+func (s *Server) writeLogLine(level, msg, method string, latency time.Duration) {
+	s.logMu.Lock()
+	format := s.logFormat
+	w := s.logOutput
+	s.logMu.Unlock()
+
+	if format == "json" {
+		line := logLine{
+			Time:   time.Now().UTC().Format(time.RFC3339Nano),
+			Level:  level,
+			Msg:    msg,
+			Method: method,
+		}
+		if latency > 0 {
+			line.LatencyMs = float64(latency) / float64(time.Millisecond)
+		}
+		data, err := json.Marshal(line)
+		if err != nil {
+			return
+		}
+		fmt.Fprintln(w, string(data))
+		return
+	}
+
+	switch {
+	case method != "":
+		fmt.Fprintf(w, "[chop] %s status=%s latency=%s\n", method, level, latency)
+	default:
+		fmt.Fprintf(w, "[chop] %s\n", msg)
+	}
+}
+
+// LogStart emits a server-start lifecycle event in the configured log
+// format, unconditionally (unlike per-request logs, which are gated on
+// SetVerbose).
+func (s *Server) LogStart(addr string) {
+	s.writeLogLine("info", fmt.Sprintf("chop listening on %s", addr), "", 0)
+}
+
+// LogShutdown emits a server-shutdown lifecycle event in the
+// configured log format.
+func (s *Server) LogShutdown(reason string) {
+	s.writeLogLine("info", fmt.Sprintf("chop shutting down: %s", reason), "", 0)
+}
+
+// SetAllowedOrigins restricts the Access-Control-Allow-Origin values
+// ServeHTTP will echo back to origins; an empty slice is treated as
+// allowing every origin.
+func (s *Server) SetAllowedOrigins(origins []string) {
+	if len(origins) == 0 {
+		origins = []string{"*"}
+	}
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.allowedOrigins = origins
+}
+
+// originAllowed reports whether origin may access the server, and
+// the Access-Control-Allow-Origin value to send back for it.
+func (s *Server) originAllowed(origin string) (string, bool) {
+	s.cfgMu.Lock()
+	allowedOrigins := s.allowedOrigins
+	s.cfgMu.Unlock()
+
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" {
+			return "*", true
+		}
+		if allowed == origin {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+// SetAuthToken requires every JSON-RPC request (not GET /health or
+// GET /ready) to carry "Authorization: Bearer <token>", answering
+// HTTP 401 otherwise. An empty token disables auth, the default.
+func (s *Server) SetAuthToken(token string) {
+	s.authToken = token
+}
+
+// authorized reports whether r carries the configured bearer token.
+// Always true when no token is configured. Compares in constant time
+// so a client can't use response timing to learn how many leading
+// bytes of the token it guessed correctly.
+func (s *Server) authorized(r *http.Request) bool {
+	if s.authToken == "" {
+		return true
+	}
+	got := r.Header.Get("Authorization")
+	want := "Bearer " + s.authToken
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
+
+// SetGasPrice sets the constant wei-per-gas eth_gasPrice reports.
+func (s *Server) SetGasPrice(wei uint64) {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.gasPrice = wei
+}
+
+// GasPrice returns the constant wei-per-gas eth_gasPrice reports.
+func (s *Server) GasPrice() uint64 {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	return s.gasPrice
+}
+
+// SetMaxBatchSize caps how many requests a single JSON-RPC batch may
+// carry, so one HTTP request can't force the server to do unbounded
+// work. n <= 0 rejects every batch, including an empty one.
+func (s *Server) SetMaxBatchSize(n int) {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.maxBatchSize = n
+}
+
+// maxBatchSizeLimit returns the current maximum JSON-RPC batch size.
+func (s *Server) maxBatchSizeLimit() int {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	return s.maxBatchSize
+}
+
+// SetReady marks the server ready (or not) for traffic, reported by
+// GET /ready. Servers start out not ready; RegisterHealthCheck marks
+// one ready once its chain and accounts are set up.
+func (s *Server) SetReady(ready bool) {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+	s.ready = ready
+}
+
+// SetHealthFunc registers the callback GET /health uses to report
+// block height and account count. Until set, both report 0.
+func (s *Server) SetHealthFunc(fn func() (blockHeight uint64, accounts int)) {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+	s.healthFn = fn
+}
+
+// healthBody is the JSON body GET /health responds with.
+type healthBody struct {
+	Status        string `json:"status"`
+	BlockHeight   uint64 `json:"blockHeight"`
+	UptimeSeconds int64  `json:"uptimeSeconds"`
+	Accounts      int    `json:"accounts"`
+}
+
+// serveHealth answers GET /health, used by container orchestrators
+// and load balancers that need a plain HTTP (non-JSON-RPC) check.
+func (s *Server) serveHealth(w http.ResponseWriter, r *http.Request) {
+	s.healthMu.Lock()
+	fn := s.healthFn
+	s.healthMu.Unlock()
+
+	var blockHeight uint64
+	var accounts int
+	if fn != nil {
+		blockHeight, accounts = fn()
+	}
+	writeJSON(w, healthBody{
+		Status:        "ok",
+		BlockHeight:   blockHeight,
+		UptimeSeconds: int64(time.Since(s.startedAt).Seconds()),
+		Accounts:      accounts,
+	})
+}
+
+// serveReady answers GET /ready: 200 once SetReady(true) has been
+// called, 503 until then.
+func (s *Server) serveReady(w http.ResponseWriter, r *http.Request) {
+	s.healthMu.Lock()
+	ready := s.ready
+	s.healthMu.Unlock()
+
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, healthBody{Status: "not ready"})
+		return
+	}
+	writeJSON(w, healthBody{Status: "ready"})
+}
+
+// Register adds a method handler, overwriting any existing handler for
+// the same name.
+func (s *Server) Register(name string, h Handler) {
+	s.methods[name] = h
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// Serve starts an HTTP server on addr with s as its handler, blocking
+// until Stop shuts it down (or it fails to start). Use Stop, not an
+// external context, to end it gracefully.
+func (s *Server) Serve(addr string) error {
+	s.httpServer = &http.Server{Addr: addr, Handler: s}
+	err := s.httpServer.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// Stop gracefully shuts the server down: it stops accepting new
+// connections immediately, then waits for in-flight requests (tracked
+// explicitly via inFlightWG rather than relying solely on
+// http.Server.Shutdown, so Stop also works on a Server never started
+// with Serve, e.g. in tests) to finish before ctx's deadline. If the
+// deadline passes first, it force-closes the listener and any open
+// connections and returns a descriptive error.
+func (s *Server) Stop(ctx context.Context) error {
+	if s.httpServer != nil {
+		go s.httpServer.Shutdown(ctx)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.inFlightWG.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		if s.httpServer != nil {
+			s.httpServer.Close()
+		}
+		return fmt.Errorf("rpcserver: shutdown deadline exceeded with requests still in flight, forced close: %w", ctx.Err())
+	}
+}
+
+// ServeHTTP implements http.Handler. OPTIONS preflights, GET /health
+// and GET /ready are answered directly, and a request carrying the
+// WebSocket upgrade headers is handed off to serveWS. Everything else
+// is decoded as plain HTTP JSON-RPC: a single request gets one
+// response, and a JSON array is handled as a batch by serveBatch.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.inFlightWG.Add(1)
+	defer s.inFlightWG.Done()
+
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if allowed, ok := s.originAllowed(origin); ok {
+			w.Header().Set("Access-Control-Allow-Origin", allowed)
+			w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		}
+	}
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method == http.MethodGet && r.URL.Path == "/health" {
+		s.serveHealth(w, r)
+		return
+	}
+	if r.Method == http.MethodGet && r.URL.Path == "/ready" {
+		s.serveReady(w, r)
+		return
+	}
+	if !s.authorized(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, response{JSONRPC: "2.0", Error: &rpcError{Code: -32001, Message: "unauthorized"}})
+		return
+	}
+	if !s.allowRequest(r.RemoteAddr) {
+		writeRateLimitError(w)
+		return
+	}
+	if strings.Contains(strings.ToLower(r.Header.Get("Upgrade")), "websocket") {
+		s.serveWS(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, nil, -32700, "parse error")
+		return
+	}
+	if isBatch(body) {
+		s.serveBatch(w, body)
+		return
+	}
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeError(w, nil, -32700, "parse error")
+		return
+	}
+	writeJSON(w, s.handle(req, nil))
+}
+
+// isBatch reports whether body is a JSON-RPC batch request: a JSON
+// array rather than a single request object.
+func isBatch(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// serveBatch decodes body as an array of JSON-RPC requests, dispatches
+// each independently, and writes back an array of responses in the
+// same order, preserving each request's ID. A malformed element in
+// the batch gets its own error response rather than failing the whole
+// batch.
+func (s *Server) serveBatch(w http.ResponseWriter, body []byte) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(body, &raws); err != nil {
+		writeError(w, nil, -32700, "parse error")
+		return
+	}
+	if len(raws) == 0 {
+		writeError(w, nil, -32600, "invalid request: empty batch")
+		return
+	}
+	if maxBatchSize := s.maxBatchSizeLimit(); len(raws) > maxBatchSize {
+		writeError(w, nil, -32600, fmt.Sprintf("batch of %d requests exceeds the max of %d", len(raws), maxBatchSize))
+		return
+	}
+
+	responses := make([]response, len(raws))
+	for i, raw := range raws {
+		var req request
+		if err := json.Unmarshal(raw, &req); err != nil {
+			responses[i] = response{JSONRPC: "2.0", Error: &rpcError{Code: -32600, Message: "invalid request"}}
+			continue
+		}
+		responses[i] = s.handle(req, nil)
+	}
+	writeJSON(w, responses)
+}
+
+// handle dispatches req to its registered method, logs it, and builds
+// the JSON-RPC response. conn is non-nil only for requests arriving
+// over a WebSocket connection, which eth_subscribe needs to register
+// a push target.
+func (s *Server) handle(req request, conn wsConn) response {
+	start := time.Now()
+	resp := s.dispatch(req, conn)
+	s.logRequest(LogEntry{
+		Method:     req.Method,
+		ParamsSize: len(req.Params),
+		Status:     statusOf(resp),
+		Latency:    time.Since(start),
+	})
+	return resp
+}
+
+func statusOf(resp response) string {
+	if resp.Error != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+func (s *Server) dispatch(req request, conn wsConn) response {
+	if req.Method == "eth_subscribe" || req.Method == "eth_unsubscribe" {
+		return s.handleSubscriptionMethod(req, conn)
+	}
+	h, ok := s.methods[req.Method]
+	if !ok {
+		return response{JSONRPC: "2.0", ID: req.ID, Error: &rpcError{Code: -32601, Message: "method not found"}}
+	}
+	result, err := h(req.Params)
+	if err != nil {
+		if revErr, ok := err.(*revertError); ok {
+			return response{JSONRPC: "2.0", ID: req.ID, Error: &rpcError{Code: 3, Message: revErr.message, Data: revErr.data}}
+		}
+		return response{JSONRPC: "2.0", ID: req.ID, Error: &rpcError{Code: -32000, Message: err.Error()}}
+	}
+	return response{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func writeError(w http.ResponseWriter, id json.RawMessage, code int, msg string) {
+	writeJSON(w, response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: msg}})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
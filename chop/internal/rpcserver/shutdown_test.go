@@ -0,0 +1,62 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStopWaitsForInFlightRequestToComplete(t *testing.T) {
+	s := New()
+	var completed int32
+	s.Register("chop_slow", func(_ json.RawMessage) (any, error) {
+		time.Sleep(50 * time.Millisecond)
+		atomic.StoreInt32(&completed, 1)
+		return "done", nil
+	})
+
+	started := make(chan struct{})
+	go func() {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_slow","params":[]}`))
+		close(started)
+		s.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	<-started
+	time.Sleep(5 * time.Millisecond) // let the handler actually start before we call Stop
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Stop(ctx); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if atomic.LoadInt32(&completed) != 1 {
+		t.Error("want the in-flight request to have completed before Stop returned")
+	}
+}
+
+func TestStopForceClosesAndErrorsPastDeadline(t *testing.T) {
+	s := New()
+	s.Register("chop_slow", func(_ json.RawMessage) (any, error) {
+		time.Sleep(200 * time.Millisecond)
+		return "done", nil
+	})
+
+	started := make(chan struct{})
+	go func() {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"chop_slow","params":[]}`))
+		close(started)
+		s.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	<-started
+	time.Sleep(5 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Stop(ctx); err == nil {
+		t.Error("want Stop to return an error when the deadline passes before draining finishes")
+	}
+}
@@ -0,0 +1,17 @@
+package rpcserver
+
+import "github.com/evmts/chop/internal/blockchain"
+
+// newHead is the minimal block header chop pushes to "newHeads"
+// subscribers.
+type newHead struct {
+	Number string `json:"number"`
+}
+
+// RegisterSubscriptions wires chain's block-mined hook to push a
+// "newHeads" notification to every subscribed WebSocket connection.
+func RegisterSubscriptions(s *Server, chain *blockchain.Chain) {
+	chain.OnNewHead(func(block blockchain.Block) {
+		s.notify("newHeads", newHead{Number: encodeQuantity(block.Number)})
+	})
+}
@@ -0,0 +1,181 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	// chop is a local dev tool; any origin (including browser dapps)
+	// may connect.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// wsConn is the minimal surface serveWS and subscriptions need from a
+// WebSocket connection, so tests can fake it without a real socket.
+type wsConn interface {
+	WriteJSON(v any) error
+}
+
+// subscription is one client's eth_subscribe registration. conn is
+// already safe for concurrent writes (see mutexConn), so no
+// additional locking is needed here.
+type subscription struct {
+	kind string
+	conn wsConn
+}
+
+// serveWS upgrades the HTTP connection and answers every JSON-RPC
+// request sent over it, keeping the socket open to push
+// eth_subscribe notifications until the client disconnects.
+func (s *Server) serveWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var writeMu sync.Mutex
+	wrapped := &mutexConn{conn: conn, mu: &writeMu}
+
+	defer s.removeSubscriptionsFor(wrapped)
+
+	for {
+		var req request
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+		resp := s.handle(req, wrapped)
+		writeMu.Lock()
+		err := conn.WriteJSON(resp)
+		writeMu.Unlock()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// mutexConn serializes writes to a *websocket.Conn, since gorilla's
+// connections aren't safe for concurrent writers and both the read
+// loop's responses and asynchronous subscription pushes write to it.
+type mutexConn struct {
+	conn *websocket.Conn
+	mu   *sync.Mutex
+}
+
+func (c *mutexConn) WriteJSON(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+// handleSubscriptionMethod answers eth_subscribe/eth_unsubscribe.
+// Subscribing outside of a WebSocket connection is rejected, since
+// there's no connection to push notifications over.
+func (s *Server) handleSubscriptionMethod(req request, conn wsConn) response {
+	switch req.Method {
+	case "eth_subscribe":
+		if conn == nil {
+			return errorResponse(req.ID, -32000, "eth_subscribe requires a WebSocket connection")
+		}
+		var params []string
+		if err := json.Unmarshal(req.Params, &params); err != nil || len(params) == 0 {
+			return errorResponse(req.ID, -32602, "eth_subscribe requires a subscription type")
+		}
+		if params[0] != "newHeads" {
+			return errorResponse(req.ID, -32602, fmt.Sprintf("unsupported subscription type %q", params[0]))
+		}
+		id := s.addSubscription(params[0], conn)
+		return response{JSONRPC: "2.0", ID: req.ID, Result: id}
+
+	default: // eth_unsubscribe
+		var params []string
+		if err := json.Unmarshal(req.Params, &params); err != nil || len(params) == 0 {
+			return errorResponse(req.ID, -32602, "eth_unsubscribe requires a subscription id")
+		}
+		return response{JSONRPC: "2.0", ID: req.ID, Result: s.removeSubscription(params[0])}
+	}
+}
+
+func errorResponse(id json.RawMessage, code int, msg string) response {
+	return response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: msg}}
+}
+
+// addSubscription registers conn for notifications of kind and
+// returns its subscription ID.
+func (s *Server) addSubscription(kind string, conn wsConn) string {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	s.nextSubID++
+	id := fmt.Sprintf("0x%x", s.nextSubID)
+	s.subs[id] = &subscription{kind: kind, conn: conn}
+	return id
+}
+
+// removeSubscription cancels the subscription with id, reporting
+// whether one existed.
+func (s *Server) removeSubscription(id string) bool {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	if _, ok := s.subs[id]; !ok {
+		return false
+	}
+	delete(s.subs, id)
+	return true
+}
+
+// removeSubscriptionsFor drops every subscription registered against
+// conn, called once its connection closes.
+func (s *Server) removeSubscriptionsFor(conn wsConn) {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+	for id, sub := range s.subs {
+		if sub.conn == conn {
+			delete(s.subs, id)
+		}
+	}
+}
+
+// subscriptionNotification is the JSON-RPC notification shape
+// eth_subscribe pushes, distinct from a request/response pair since
+// it carries no id.
+type subscriptionNotification struct {
+	JSONRPC string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  notificationParams `json:"params"`
+}
+
+type notificationParams struct {
+	Subscription string `json:"subscription"`
+	Result       any    `json:"result"`
+}
+
+// notify pushes result to every subscription of kind, dropping (and
+// logging nothing further about) any connection that fails to write.
+func (s *Server) notify(kind string, result any) {
+	s.subsMu.Lock()
+	subs := make(map[string]*subscription, len(s.subs))
+	for id, sub := range s.subs {
+		if sub.kind == kind {
+			subs[id] = sub
+		}
+	}
+	s.subsMu.Unlock()
+
+	for id, sub := range subs {
+		err := sub.conn.WriteJSON(subscriptionNotification{
+			JSONRPC: "2.0",
+			Method:  "eth_subscription",
+			Params:  notificationParams{Subscription: id, Result: result},
+		})
+		if err != nil {
+			s.subsMu.Lock()
+			delete(s.subs, id)
+			s.subsMu.Unlock()
+		}
+	}
+}
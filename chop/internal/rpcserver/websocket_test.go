@@ -0,0 +1,110 @@
+package rpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestEthSubscribeNewHeadsReceivesBlockOnSendTransaction(t *testing.T) {
+	chain := blockchain.New()
+	alice := types.Address{0x01}
+	chain.Accounts.SetBalance(alice, big.NewInt(1000))
+
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{result: types.CallResult{Status: true}})
+	RegisterSubscriptions(s, chain)
+
+	httpSrv := httptest.NewServer(s)
+	defer httpSrv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(httpSrv.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("dialing ws server: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_subscribe", "params": []string{"newHeads"}}); err != nil {
+		t.Fatalf("sending eth_subscribe: %v", err)
+	}
+	var subResp map[string]any
+	if err := conn.ReadJSON(&subResp); err != nil {
+		t.Fatalf("reading eth_subscribe response: %v", err)
+	}
+	if _, ok := subResp["result"].(string); !ok {
+		t.Fatalf("want a subscription id, got %v", subResp)
+	}
+
+	body, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0", "id": 2, "method": "eth_sendTransaction",
+		"params": []any{map[string]string{
+			"from":  "0x0100000000000000000000000000000000000000",
+			"to":    "0x0200000000000000000000000000000000000000",
+			"value": "0x1",
+		}},
+	})
+	resp, err := http.Post(httpSrv.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("posting eth_sendTransaction: %v", err)
+	}
+	resp.Body.Close()
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var notification map[string]any
+	if err := conn.ReadJSON(&notification); err != nil {
+		t.Fatalf("reading newHeads notification: %v", err)
+	}
+	if notification["method"] != "eth_subscription" {
+		t.Errorf("got method %v, want eth_subscription", notification["method"])
+	}
+	params, ok := notification["params"].(map[string]any)
+	if !ok {
+		t.Fatalf("want params object, got %v", notification["params"])
+	}
+	result, ok := params["result"].(map[string]any)
+	if !ok || result["number"] != "0x1" {
+		t.Errorf("got result %v, want block number 0x1", params["result"])
+	}
+}
+
+func TestEthUnsubscribe(t *testing.T) {
+	chain := blockchain.New()
+	s := New()
+	RegisterEthMethods(s, chain, fakeExecutor{})
+	RegisterSubscriptions(s, chain)
+
+	httpSrv := httptest.NewServer(s)
+	defer httpSrv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(httpSrv.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("dialing ws server: %v", err)
+	}
+	defer conn.Close()
+
+	conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "eth_subscribe", "params": []string{"newHeads"}})
+	var subResp map[string]any
+	conn.ReadJSON(&subResp)
+	id := subResp["result"].(string)
+
+	conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": 2, "method": "eth_unsubscribe", "params": []string{id}})
+	var unsubResp map[string]any
+	if err := conn.ReadJSON(&unsubResp); err != nil {
+		t.Fatalf("reading eth_unsubscribe response: %v", err)
+	}
+	if unsubResp["result"] != true {
+		t.Errorf("got result %v, want true", unsubResp["result"])
+	}
+}
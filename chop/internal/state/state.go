@@ -0,0 +1,124 @@
+// Package state persists chop's call history to disk so it survives
+// across invocations and can be replayed later via `chop replay`.
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/types"
+)
+
+// StoredCall is a single persisted call, as appended by the TUI and
+// later re-executed by `chop replay`.
+type StoredCall struct {
+	Index     int                  `json:"index"`
+	Timestamp time.Time            `json:"timestamp"`
+	Context   evm.ExecutionContext `json:"context"`
+	Expected  *types.CallResult    `json:"expected,omitempty"`
+}
+
+// GetStateFilePath returns the on-disk location of the persisted call
+// history, honoring $CHOP_STATE_FILE if set.
+func GetStateFilePath() (string, error) {
+	if p := os.Getenv("CHOP_STATE_FILE"); p != "" {
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "chop", "state.json"), nil
+}
+
+// Load reads every persisted call, or an empty slice if the state
+// file doesn't exist yet.
+func Load() ([]StoredCall, error) {
+	path, err := GetStateFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var calls []StoredCall
+	if err := json.Unmarshal(data, &calls); err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
+
+// AppendCall persists ctx (and its expected result, if known) as the
+// next entry and returns the index it was stored under.
+func AppendCall(ctx evm.ExecutionContext, expected *types.CallResult) (int, error) {
+	calls, err := Load()
+	if err != nil {
+		return 0, err
+	}
+	entry := StoredCall{Index: len(calls), Timestamp: time.Now(), Context: ctx, Expected: expected}
+	calls = append(calls, entry)
+
+	path, err := GetStateFilePath()
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+	data, err := json.MarshalIndent(calls, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return 0, err
+	}
+	return entry.Index, nil
+}
+
+// ByIndex returns the stored call at index, or an error if out of
+// range.
+func ByIndex(index int) (StoredCall, error) {
+	calls, err := Load()
+	if err != nil {
+		return StoredCall{}, err
+	}
+	if index < 0 || index >= len(calls) {
+		return StoredCall{}, fmt.Errorf("no stored call at index %d (have %d)", index, len(calls))
+	}
+	return calls[index], nil
+}
+
+// ByTimestamp returns the stored call whose Timestamp is closest to
+// t, or an error if there are no stored calls at all.
+func ByTimestamp(t time.Time) (StoredCall, error) {
+	calls, err := Load()
+	if err != nil {
+		return StoredCall{}, err
+	}
+	if len(calls) == 0 {
+		return StoredCall{}, fmt.Errorf("no stored calls")
+	}
+	best := calls[0]
+	bestDiff := absDuration(best.Timestamp.Sub(t))
+	for _, c := range calls[1:] {
+		if d := absDuration(c.Timestamp.Sub(t)); d < bestDiff {
+			best, bestDiff = c, d
+		}
+	}
+	return best, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,43 @@
+package state
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/evmts/chop/internal/evm"
+)
+
+func TestAppendAndLoadRoundtrip(t *testing.T) {
+	t.Setenv("CHOP_STATE_FILE", filepath.Join(t.TempDir(), "state.json"))
+
+	idx, err := AppendCall(evm.ExecutionContext{GasLimit: 21000}, nil)
+	if err != nil {
+		t.Fatalf("AppendCall: %v", err)
+	}
+	if idx != 0 {
+		t.Errorf("want first index 0, got %d", idx)
+	}
+
+	idx2, err := AppendCall(evm.ExecutionContext{GasLimit: 50000}, nil)
+	if err != nil {
+		t.Fatalf("AppendCall: %v", err)
+	}
+	if idx2 != 1 {
+		t.Errorf("want second index 1, got %d", idx2)
+	}
+
+	sc, err := ByIndex(1)
+	if err != nil {
+		t.Fatalf("ByIndex: %v", err)
+	}
+	if sc.Context.GasLimit != 50000 {
+		t.Errorf("got gas limit %d, want 50000", sc.Context.GasLimit)
+	}
+}
+
+func TestByIndexOutOfRange(t *testing.T) {
+	t.Setenv("CHOP_STATE_FILE", filepath.Join(t.TempDir(), "state.json"))
+	if _, err := ByIndex(0); err == nil {
+		t.Error("want error for empty state")
+	}
+}
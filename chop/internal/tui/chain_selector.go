@@ -0,0 +1,153 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/chains"
+	"github.com/evmts/chop/internal/config"
+)
+
+var chainSelectorColumns = []table.Column{
+	{Title: "Name", Width: 20},
+	{Title: "Chain ID", Width: 10},
+	{Title: "Currency", Width: 10},
+}
+
+// ChainSelectorModel lets the user pick chop's active chain from
+// chains.AllChains instead of editing --chain-id by hand. Typing
+// filters the table by name, short name, or chain ID; "enter" selects
+// the highlighted row, updating ChainID/Decimals and persisting the
+// choice to the config file.
+type ChainSelectorModel struct {
+	all      []chains.Chain
+	filtered []chains.Chain
+	search   textinput.Model
+	table    table.Model
+
+	chainID  uint64
+	decimals uint8
+	lastErr  error
+}
+
+// NewChainSelectorModel builds a ChainSelectorModel listing every
+// chain in chains.AllChains. chainID and decimals seed the initial
+// "active chain" reported by ChainID/Decimals before anything is
+// selected, typically the values already in effect (e.g. cfg.ChainID).
+func NewChainSelectorModel(chainID uint64, decimals uint8) ChainSelectorModel {
+	search := textinput.New()
+	search.Placeholder = "search by name, short name, or chain ID"
+	search.Focus()
+
+	m := ChainSelectorModel{
+		all:      chains.AllChains(),
+		search:   search,
+		table:    table.New(table.WithColumns(chainSelectorColumns)),
+		chainID:  chainID,
+		decimals: decimals,
+	}
+	m.refresh()
+	return m
+}
+
+// ChainID returns the chain ID of the most recently selected chain.
+func (m ChainSelectorModel) ChainID() uint64 { return m.chainID }
+
+// Decimals returns the native currency decimals of the most recently
+// selected chain, for callers formatting balances (see
+// render.FormatBalance).
+func (m ChainSelectorModel) Decimals() uint8 { return m.decimals }
+
+// refresh filters m.all against the search box's current value and
+// redraws the table from the result.
+func (m *ChainSelectorModel) refresh() {
+	query := strings.ToLower(strings.TrimSpace(m.search.Value()))
+	filtered := make([]chains.Chain, 0, len(m.all))
+	rows := make([]table.Row, 0, len(m.all))
+	for _, c := range m.all {
+		if query != "" && !chainMatches(c, query) {
+			continue
+		}
+		filtered = append(filtered, c)
+		rows = append(rows, table.Row{c.Name, strconv.FormatUint(c.ID, 10), c.NativeCurrency.Symbol})
+	}
+	m.filtered = filtered
+	m.table.SetRows(rows)
+	m.table.SetCursor(0)
+}
+
+// chainMatches reports whether c's name, short name, or chain ID
+// contains query, which has already been lowercased.
+func chainMatches(c chains.Chain, query string) bool {
+	return strings.Contains(strings.ToLower(c.Name), query) ||
+		strings.Contains(strings.ToLower(c.ShortName), query) ||
+		strings.Contains(strconv.FormatUint(c.ID, 10), query)
+}
+
+// selectHighlighted sets ChainID/Decimals from the row under the
+// cursor and persists the choice to the config file. Selecting while
+// the filtered list is empty is a no-op.
+func (m *ChainSelectorModel) selectHighlighted() {
+	idx := m.table.Cursor()
+	if idx < 0 || idx >= len(m.filtered) {
+		return
+	}
+	c := m.filtered[idx]
+	m.chainID = c.ID
+	m.decimals = c.NativeCurrency.Decimals
+
+	cfg, err := config.Load("")
+	if err != nil {
+		m.lastErr = err
+		return
+	}
+	cfg.ChainID = c.ID
+	if err := config.Save(cfg); err != nil {
+		m.lastErr = err
+		return
+	}
+	m.lastErr = nil
+}
+
+func (m ChainSelectorModel) Init() tea.Cmd { return nil }
+
+func (m ChainSelectorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "enter":
+		m.selectHighlighted()
+		return m, nil
+	case "up", "down", "pgup", "pgdown", "home", "end":
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	m.refresh()
+	return m, cmd
+}
+
+func (m ChainSelectorModel) View() string {
+	view := m.search.View() + "\n" + m.table.View() + "\n"
+	if m.chainID != 0 {
+		view += fmt.Sprintf("active chain ID: %d\n", m.chainID)
+	}
+	if m.lastErr != nil {
+		view += "error: " + m.lastErr.Error() + "\n"
+	}
+	view += "enter to select, persisting the choice to config\n"
+	return view
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestChainSelectorFiltersByQuery(t *testing.T) {
+	m := NewChainSelectorModel(0, 0)
+
+	var model tea.Model = m
+	for _, r := range "ronin" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	got := model.(ChainSelectorModel)
+	if len(got.filtered) != 1 {
+		t.Fatalf("got %d filtered chains, want 1", len(got.filtered))
+	}
+	if got.filtered[0].ShortName != "ronin" {
+		t.Errorf("got short name %q, want ronin", got.filtered[0].ShortName)
+	}
+}
+
+func TestChainSelectorUpdatesChainIDOnSelect(t *testing.T) {
+	t.Setenv("CHOP_CONFIG", filepath.Join(t.TempDir(), "config.json"))
+
+	m := NewChainSelectorModel(0, 0)
+
+	var model tea.Model = m
+	for _, r := range "ronin" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := model.(ChainSelectorModel)
+	if got.ChainID() != 2020 {
+		t.Errorf("got chain ID %d, want 2020", got.ChainID())
+	}
+	if got.Decimals() != 18 {
+		t.Errorf("got decimals %d, want 18", got.Decimals())
+	}
+	if got.lastErr != nil {
+		t.Errorf("unexpected error persisting selection: %v", got.lastErr)
+	}
+}
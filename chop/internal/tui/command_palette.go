@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Command is one action the command palette can jump to: a display
+// Name plus the Target identifier CommandPaletteModel reports when
+// it's chosen. Target is opaque to CommandPaletteModel - callers
+// define whatever identifiers make sense for their own state machine
+// (a tab name, a state constant, whatever "Go to Accounts" means for
+// the embedding model).
+type Command struct {
+	Name   string
+	Target string
+}
+
+// DefaultCommands lists the palette's built-in quick-jump actions.
+// Callers embedding CommandPaletteModel can pass this as-is or extend
+// it with their own Commands.
+func DefaultCommands() []Command {
+	return []Command{
+		{Name: "Make Call", Target: "call"},
+		{Name: "Reset State", Target: "reset"},
+		{Name: "Go to Accounts", Target: "accounts"},
+		{Name: "Save Fixture", Target: "fixtures"},
+	}
+}
+
+var commandPaletteColumns = []table.Column{{Title: "Command", Width: 30}}
+
+// CommandPaletteModel is chop TUI's Ctrl+P quick-jump overlay: a
+// fuzzy-filtered list of Commands. Embed it in a parent model, open
+// it with NewCommandPaletteModel(commands, returnTarget) when Ctrl+P
+// fires, feed it key messages via Update while it's open, and check
+// Selected/Canceled after each Update: Selected returns the chosen
+// Command's Target so the parent can jump there, Canceled reports an
+// "esc", and ReturnTarget is whatever state/tab identifier the caller
+// passed in, so Esc can jump back to exactly where the palette was
+// opened from.
+type CommandPaletteModel struct {
+	all      []Command
+	filtered []Command
+	search   textinput.Model
+	table    table.Model
+
+	returnTarget string
+	selected     string
+	canceled     bool
+}
+
+// NewCommandPaletteModel builds a palette over commands. returnTarget
+// is the identifier of the state/tab the palette was opened from.
+func NewCommandPaletteModel(commands []Command, returnTarget string) CommandPaletteModel {
+	search := textinput.New()
+	search.Placeholder = "type a command..."
+	search.Focus()
+
+	m := CommandPaletteModel{
+		all:          commands,
+		search:       search,
+		table:        table.New(table.WithColumns(commandPaletteColumns)),
+		returnTarget: returnTarget,
+	}
+	m.refresh()
+	return m
+}
+
+// ReturnTarget is the state/tab identifier the palette was opened
+// from, for the caller to jump back to when Canceled is true.
+func (m CommandPaletteModel) ReturnTarget() string { return m.returnTarget }
+
+// Selected returns the Target of the command the user picked with
+// "enter", or "" if nothing's been picked yet.
+func (m CommandPaletteModel) Selected() string { return m.selected }
+
+// Canceled reports whether the palette was closed with "esc" rather
+// than a selection.
+func (m CommandPaletteModel) Canceled() bool { return m.canceled }
+
+// refresh fuzzy-filters m.all against the search box's current value
+// and redraws the table from the result, tightest matches first.
+func (m *CommandPaletteModel) refresh() {
+	query := strings.ToLower(strings.TrimSpace(m.search.Value()))
+	if query == "" {
+		m.filtered = append([]Command(nil), m.all...)
+	} else {
+		type scored struct {
+			command Command
+			span    int
+		}
+		var hits []scored
+		for _, c := range m.all {
+			if span, ok := fuzzyMatch(strings.ToLower(c.Name), query); ok {
+				hits = append(hits, scored{c, span})
+			}
+		}
+		sort.SliceStable(hits, func(i, j int) bool { return hits[i].span < hits[j].span })
+		m.filtered = make([]Command, len(hits))
+		for i, h := range hits {
+			m.filtered[i] = h.command
+		}
+	}
+
+	rows := make([]table.Row, len(m.filtered))
+	for i, c := range m.filtered {
+		rows[i] = table.Row{c.Name}
+	}
+	m.table.SetRows(rows)
+	m.table.SetCursor(0)
+}
+
+// selectHighlighted records the Target of the row under the cursor.
+// Selecting while the filtered list is empty is a no-op.
+func (m *CommandPaletteModel) selectHighlighted() {
+	idx := m.table.Cursor()
+	if idx < 0 || idx >= len(m.filtered) {
+		return
+	}
+	m.selected = m.filtered[idx].Target
+}
+
+func (m CommandPaletteModel) Init() tea.Cmd { return nil }
+
+func (m CommandPaletteModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		m.canceled = true
+		return m, nil
+	case "enter":
+		m.selectHighlighted()
+		return m, nil
+	case "up", "down", "pgup", "pgdown", "home", "end":
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	m.refresh()
+	return m, cmd
+}
+
+func (m CommandPaletteModel) View() string {
+	view := "command palette\n" + m.search.View() + "\n" + m.table.View() + "\n"
+	view += "enter to jump, esc to cancel\n"
+	return view
+}
+
+// fuzzyMatch reports whether every rune of query appears in text in
+// order (not necessarily contiguous), and if so returns the span
+// between the first and last matched rune as a rough tightness score
+// - a smaller span ranks above a looser, more scattered match.
+func fuzzyMatch(text, query string) (span int, ok bool) {
+	queryRunes := []rune(query)
+	if len(queryRunes) == 0 {
+		return 0, true
+	}
+	textRunes := []rune(text)
+	qi := 0
+	start, end := -1, -1
+	for i, r := range textRunes {
+		if qi < len(queryRunes) && r == queryRunes[qi] {
+			if start == -1 {
+				start = i
+			}
+			end = i
+			qi++
+		}
+	}
+	if qi != len(queryRunes) {
+		return 0, false
+	}
+	return end - start, true
+}
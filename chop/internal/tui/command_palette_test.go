@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCommandPaletteSelectingAccountsReturnsItsTarget(t *testing.T) {
+	m := NewCommandPaletteModel(DefaultCommands(), "call")
+
+	var model tea.Model = m
+	for _, r := range "accounts" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := model.(CommandPaletteModel)
+	if got.Selected() != "accounts" {
+		t.Errorf("got Selected() = %q, want %q", got.Selected(), "accounts")
+	}
+	if got.Canceled() {
+		t.Error("got Canceled() = true after a selection, want false")
+	}
+}
+
+func TestCommandPaletteFuzzyMatchesOutOfOrderLetters(t *testing.T) {
+	m := NewCommandPaletteModel(DefaultCommands(), "call")
+
+	var model tea.Model = m
+	for _, r := range "mkcl" { // subsequence of "Make Call"
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	got := model.(CommandPaletteModel)
+	if len(got.filtered) != 1 || got.filtered[0].Target != "call" {
+		t.Fatalf("got filtered %+v, want only \"Make Call\"", got.filtered)
+	}
+}
+
+func TestCommandPaletteEscCancelsAndPreservesReturnTarget(t *testing.T) {
+	m := NewCommandPaletteModel(DefaultCommands(), "fixtures")
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	got := model.(CommandPaletteModel)
+	if !got.Canceled() {
+		t.Error("want Canceled() = true after esc")
+	}
+	if got.Selected() != "" {
+		t.Errorf("got Selected() = %q after esc, want empty", got.Selected())
+	}
+	if got.ReturnTarget() != "fixtures" {
+		t.Errorf("got ReturnTarget() = %q, want %q", got.ReturnTarget(), "fixtures")
+	}
+}
+
+func TestFuzzyMatchRequiresInOrderSubsequence(t *testing.T) {
+	if _, ok := fuzzyMatch("accounts", "xyz"); ok {
+		t.Error("want no match for letters not present")
+	}
+	if _, ok := fuzzyMatch("accounts", "tsa"); ok {
+		t.Error("want no match when letters are out of order")
+	}
+	if _, ok := fuzzyMatch("accounts", "acnt"); !ok {
+		t.Error("want a match for an in-order subsequence")
+	}
+}
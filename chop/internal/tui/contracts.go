@@ -0,0 +1,55 @@
+// Package tui implements chop's terminal UI views.
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/contracts"
+)
+
+// ContractsModel renders the "Contracts" analytics view: every contract
+// address seen so far, sorted by call count then gas used.
+type ContractsModel struct {
+	tracker *contracts.Tracker
+	table   table.Model
+}
+
+// NewContractsModel builds a ContractsModel backed by tracker.
+func NewContractsModel(tracker *contracts.Tracker) ContractsModel {
+	columns := []table.Column{
+		{Title: "Address", Width: 42},
+		{Title: "Calls", Width: 8},
+		{Title: "Gas", Width: 14},
+	}
+	m := ContractsModel{tracker: tracker, table: table.New(table.WithColumns(columns))}
+	m.Refresh()
+	return m
+}
+
+// Refresh recomputes the table rows from the tracker. Call after every
+// executed call and after a chain reset.
+func (m *ContractsModel) Refresh() {
+	stats := m.tracker.Sorted()
+	rows := make([]table.Row, 0, len(stats))
+	for _, s := range stats {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("0x%x", s.Address),
+			fmt.Sprintf("%d", s.Calls),
+			fmt.Sprintf("%d", s.GasUsed),
+		})
+	}
+	m.table.SetRows(rows)
+}
+
+func (m ContractsModel) Init() tea.Cmd { return nil }
+
+func (m ContractsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m ContractsModel) View() string { return m.table.View() }
@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/abi"
+	"github.com/evmts/chop/internal/render"
+	"github.com/evmts/chop/internal/types"
+)
+
+// DetailModel shows a single CallResult's detail view, with hex fields
+// truncated to truncateLen by default. "t" toggles the full value
+// inline; "p" opens the full value in $PAGER; "c" copies the result as
+// formatted JSON to the system clipboard, for pasting into a bug
+// report; "r" prompts for a comma-separated list of Solidity return
+// types (e.g. "uint256,bool") and shows Output decoded against them.
+type DetailModel struct {
+	result      types.CallResult
+	truncateLen int
+	full        bool
+
+	enteringTypes    bool
+	returnTypesInput textinput.Model
+	decoded          []string
+	decodeErr        error
+}
+
+// NewDetailModel builds a DetailModel truncating hex fields to
+// truncateLen characters until toggled open.
+func NewDetailModel(result types.CallResult, truncateLen int) DetailModel {
+	return DetailModel{result: result, truncateLen: truncateLen}
+}
+
+func (m DetailModel) Init() tea.Cmd { return nil }
+
+func (m DetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	if m.enteringTypes {
+		switch keyMsg.String() {
+		case "enter":
+			m.applyReturnTypes()
+			m.enteringTypes = false
+			return m, nil
+		case "esc":
+			m.enteringTypes = false
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.returnTypesInput, cmd = m.returnTypesInput.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "t":
+		m.full = !m.full
+	case "p":
+		return m, m.pagerCmd()
+	case "c":
+		return m, m.copyCmd()
+	case "r":
+		m.enteringTypes = true
+		m.returnTypesInput = textinput.New()
+		m.returnTypesInput.Placeholder = "uint256,bool,..."
+		m.returnTypesInput.Focus()
+		m.decoded = nil
+		m.decodeErr = nil
+	}
+	return m, nil
+}
+
+// applyReturnTypes decodes m.result.Output against the comma-separated
+// Solidity types entered in returnTypesInput, storing the decoded
+// values (or the error) for View to show. An empty input clears any
+// previously decoded values instead of erroring.
+func (m *DetailModel) applyReturnTypes() {
+	raw := strings.TrimSpace(m.returnTypesInput.Value())
+	if raw == "" {
+		m.decoded = nil
+		m.decodeErr = nil
+		return
+	}
+	types := strings.Split(raw, ",")
+	for i := range types {
+		types[i] = strings.TrimSpace(types[i])
+	}
+	m.decoded, m.decodeErr = abi.Decode(types, m.result.Output)
+}
+
+func (m DetailModel) View() string {
+	view := render.RenderCallResult(m.result, m.truncateLen, m.full)
+	switch {
+	case m.enteringTypes:
+		view += "\nreturn types (comma-separated): " + m.returnTypesInput.View() + "\n"
+	case m.decodeErr != nil:
+		view += "\ndecode error: " + m.decodeErr.Error() + "\n"
+	case len(m.decoded) > 0:
+		view += "\ndecoded: " + strings.Join(m.decoded, ", ") + "\n"
+	}
+	return view
+}
+
+// pagerCmd opens the untruncated detail view in $PAGER (falling back
+// to "less"), suspending the TUI for the duration.
+func (m DetailModel) pagerCmd() tea.Cmd {
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		pager = "less"
+	}
+	c := exec.Command(pager)
+	c.Stdin = strings.NewReader(render.RenderCallResult(m.result, 0, true))
+	return tea.ExecProcess(c, func(error) tea.Msg { return nil })
+}
+
+// getCopyContent returns m's result serialized as the same stable
+// JSON shape `chop call --output json` produces, pretty-printed so
+// it's readable once pasted into a bug report.
+func getCopyContent(result types.CallResult) (string, error) {
+	data, err := render.CallResultJSON(result)
+	if err != nil {
+		return "", err
+	}
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, data, "", "  "); err != nil {
+		return "", err
+	}
+	return pretty.String(), nil
+}
+
+// copyCmd copies m's result to the system clipboard via copyToClipboard.
+// Copy failures (e.g. no clipboard utility on this platform) are
+// swallowed rather than surfaced as a tea.Msg: there's no status bar
+// yet for DetailModel to report through.
+func (m DetailModel) copyCmd() tea.Cmd {
+	return func() tea.Msg {
+		content, err := getCopyContent(m.result)
+		if err == nil {
+			_ = copyToClipboard(content)
+		}
+		return nil
+	}
+}
+
+// copyToClipboard pipes content into the platform's clipboard utility,
+// the same "shell out to an external program" approach pagerCmd uses
+// for $PAGER.
+func copyToClipboard(content string) error {
+	cmd := clipboardCommand()
+	if cmd == nil {
+		return fmt.Errorf("tui: no clipboard utility found for this platform")
+	}
+	cmd.Stdin = strings.NewReader(content)
+	return cmd.Run()
+}
+
+// clipboardCommand returns the platform's clipboard-copy command, or
+// nil if none could be found.
+func clipboardCommand() *exec.Cmd {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("pbcopy")
+	case "windows":
+		return exec.Command("clip")
+	default:
+		if path, err := exec.LookPath("xclip"); err == nil {
+			return exec.Command(path, "-selection", "clipboard")
+		}
+		if path, err := exec.LookPath("xsel"); err == nil {
+			return exec.Command(path, "--clipboard", "--input")
+		}
+		return nil
+	}
+}
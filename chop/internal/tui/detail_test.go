@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textinput"
+
+	"github.com/evmts/chop/internal/types"
+)
+
+func TestGetCopyContentReturnsValidJSONWithGasLeft(t *testing.T) {
+	result := types.CallResult{
+		Status:   true,
+		GasLimit: 100000,
+		GasUsed:  21000,
+		Output:   []byte{0x01, 0x02},
+	}
+
+	content, err := getCopyContent(result)
+	if err != nil {
+		t.Fatalf("getCopyContent: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(content), &decoded); err != nil {
+		t.Fatalf("getCopyContent did not return valid JSON: %v\ncontent:\n%s", err, content)
+	}
+
+	gasLeft, ok := decoded["gasLeft"].(float64)
+	if !ok {
+		t.Fatalf("got %+v, want a numeric gasLeft field", decoded)
+	}
+	if gasLeft != 79000 {
+		t.Errorf("got gasLeft %v, want 79000", gasLeft)
+	}
+}
+
+func TestApplyReturnTypesDecodesOutputAgainstEnteredTypes(t *testing.T) {
+	output := make([]byte, 32)
+	output[31] = 42 // uint256(42)
+
+	m := NewDetailModel(types.CallResult{Status: true, Output: output}, 0)
+	m.enteringTypes = true
+	m.returnTypesInput = textinput.New()
+	m.returnTypesInput.SetValue("uint256")
+
+	m.applyReturnTypes()
+
+	if m.decodeErr != nil {
+		t.Fatalf("applyReturnTypes: %v", m.decodeErr)
+	}
+	if len(m.decoded) != 1 || m.decoded[0] != "42" {
+		t.Errorf("got decoded %v, want [\"42\"]", m.decoded)
+	}
+}
@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/fixtures"
+)
+
+// fixturesPageSize caps how many fixtures are materialized into the
+// table at once; larger lists page instead of all loading up front.
+const fixturesPageSize = 20
+
+// FixturesListModel renders the saved fixtures list, paged
+// fixturesPageSize rows at a time. Pressing "d" arms deletion of the
+// highlighted fixture; a second "d" confirms it and removes the
+// fixture from disk, while any other key cancels. "pgup"/"pgdown"
+// move between pages.
+type FixturesListModel struct {
+	table       table.Model
+	names       []string
+	pager       *Paginator
+	pendingName string
+	lastErr     error
+}
+
+// NewFixturesListModel builds a FixturesListModel listing every saved
+// fixture, or returns an error if the fixtures directory can't be read.
+func NewFixturesListModel() (FixturesListModel, error) {
+	m := FixturesListModel{
+		table: table.New(table.WithColumns([]table.Column{
+			{Title: "Name", Width: 32},
+		})),
+		pager: NewPaginator(0, fixturesPageSize),
+	}
+	if err := m.Refresh(); err != nil {
+		return FixturesListModel{}, err
+	}
+	return m, nil
+}
+
+// Refresh reloads the fixture list from disk and redraws the current page.
+func (m *FixturesListModel) Refresh() error {
+	names, err := fixtures.List()
+	if err != nil {
+		return err
+	}
+	m.names = names
+	m.pager.SetTotal(len(names))
+	m.refreshRows()
+	return nil
+}
+
+// refreshRows feeds the table only the rows on the current page.
+func (m *FixturesListModel) refreshRows() {
+	start, end := m.pager.Bounds()
+	rows := make([]table.Row, 0, end-start)
+	for _, name := range m.names[start:end] {
+		rows = append(rows, table.Row{name})
+	}
+	m.table.SetRows(rows)
+	m.table.SetCursor(0)
+}
+
+func (m FixturesListModel) Init() tea.Cmd { return nil }
+
+// selected returns the name of the fixture on the highlighted row, if any.
+func (m FixturesListModel) selected() (string, bool) {
+	idx := m.pager.Index(m.table.Cursor())
+	if idx < 0 {
+		return "", false
+	}
+	return m.names[idx], true
+}
+
+func (m FixturesListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	if m.pendingName != "" {
+		// Awaiting confirmation: a second "d" deletes, anything else cancels.
+		if keyMsg.String() == "d" {
+			name := m.pendingName
+			m.pendingName = ""
+			if err := fixtures.Delete(name); err != nil {
+				m.lastErr = err
+				return m, nil
+			}
+			m.lastErr = nil
+			if err := m.Refresh(); err != nil {
+				m.lastErr = err
+			}
+			return m, nil
+		}
+		m.pendingName = ""
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "d":
+		if name, ok := m.selected(); ok {
+			m.pendingName = name
+		}
+		return m, nil
+	case "pgdown":
+		if m.pager.NextPage() {
+			m.refreshRows()
+		}
+		return m, nil
+	case "pgup":
+		if m.pager.PrevPage() {
+			m.refreshRows()
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m FixturesListModel) View() string {
+	view := m.table.View()
+	if pages := m.pager.PageCount(); pages > 1 {
+		view += fmt.Sprintf("\npage %d/%d\n", m.pager.Page()+1, pages)
+	}
+	if m.pendingName != "" {
+		view += "\ndelete fixture \"" + m.pendingName + "\"? press d again to confirm, any other key to cancel\n"
+	}
+	if m.lastErr != nil {
+		view += "\nerror: " + m.lastErr.Error() + "\n"
+	}
+	return view
+}
@@ -0,0 +1,226 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/abi"
+)
+
+// maxParamEditHistory bounds how many parameter-input snapshots
+// FunctionSelectModel keeps for undo/redo, so a long editing session
+// doesn't grow the history without limit.
+const maxParamEditHistory = 50
+
+// FunctionSelectModel lets the user pick a function from a contract's
+// parsed ABI, fill in one text input per parameter, and ABI-encode the
+// result into calldata instead of hand-encoding it. It has two
+// stages: a table of function signatures (selected with "enter"),
+// then one text input per parameter ("tab"/"enter" to advance, "esc"
+// to go back to the function list, "ctrl+z"/"ctrl+y" to undo/redo an
+// edit to the parameter values).
+type FunctionSelectModel struct {
+	functions []abi.Function
+	table     table.Model
+	selected  *abi.Function
+	inputs    []textinput.Model
+	focus     int
+	calldata  []byte
+	err       error
+
+	undoStack [][]string
+	redoStack [][]string
+}
+
+// NewFunctionSelectModel builds a FunctionSelectModel listing every
+// function in functions, in the order returned by abi.ParseFunctions.
+func NewFunctionSelectModel(functions []abi.Function) FunctionSelectModel {
+	rows := make([]table.Row, len(functions))
+	for i, fn := range functions {
+		rows[i] = table.Row{fn.Signature()}
+	}
+	t := table.New(
+		table.WithColumns([]table.Column{{Title: "Function", Width: 40}}),
+		table.WithRows(rows),
+	)
+	return FunctionSelectModel{functions: functions, table: t}
+}
+
+// Calldata returns the ABI-encoded calldata built from the last
+// successfully filled-in function call, or nil if none has completed yet.
+func (m FunctionSelectModel) Calldata() []byte { return m.calldata }
+
+func (m FunctionSelectModel) Init() tea.Cmd { return nil }
+
+func (m FunctionSelectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m.updateActive(msg)
+	}
+
+	if m.selected == nil {
+		if keyMsg.String() == "enter" {
+			m.selectFunction(m.table.Cursor())
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		m.selected = nil
+		m.inputs = nil
+		m.err = nil
+		return m, nil
+	case "ctrl+z":
+		m.undo()
+		return m, nil
+	case "ctrl+y":
+		m.redo()
+		return m, nil
+	case "tab", "enter":
+		if m.focus < len(m.inputs)-1 {
+			m.inputs[m.focus].Blur()
+			m.focus++
+			m.inputs[m.focus].Focus()
+			return m, nil
+		}
+		m.encode()
+		return m, nil
+	}
+	return m.updateActive(msg)
+}
+
+// selectFunction arms function row for argument entry, building one
+// focused text input per parameter.
+func (m *FunctionSelectModel) selectFunction(row int) {
+	if row < 0 || row >= len(m.functions) {
+		return
+	}
+	fn := m.functions[row]
+	m.selected = &fn
+	m.calldata = nil
+	m.err = nil
+	m.focus = 0
+	m.undoStack = nil
+	m.redoStack = nil
+	m.inputs = make([]textinput.Model, len(fn.Inputs))
+	for i, paramType := range fn.Inputs {
+		ti := textinput.New()
+		ti.Placeholder = paramType
+		if i == 0 {
+			ti.Focus()
+		}
+		m.inputs[i] = ti
+	}
+}
+
+// encode ABI-encodes the selected function against the entered
+// arguments, storing the result (or the error) for View to show.
+func (m *FunctionSelectModel) encode() {
+	args := make([]string, len(m.inputs))
+	for i, ti := range m.inputs {
+		args[i] = ti.Value()
+	}
+	calldata, err := abi.Encode(m.selected.Signature(), args)
+	if err != nil {
+		m.err = err
+		m.calldata = nil
+		return
+	}
+	m.err = nil
+	m.calldata = calldata
+}
+
+func (m FunctionSelectModel) updateActive(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.selected == nil {
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+	before := m.paramValues()
+	var cmd tea.Cmd
+	m.inputs[m.focus], cmd = m.inputs[m.focus].Update(msg)
+	if m.inputs[m.focus].Value() != before[m.focus] {
+		m.pushUndo(before)
+	}
+	return m, cmd
+}
+
+// paramValues returns the current value of every parameter input, in
+// order.
+func (m FunctionSelectModel) paramValues() []string {
+	values := make([]string, len(m.inputs))
+	for i, ti := range m.inputs {
+		values[i] = ti.Value()
+	}
+	return values
+}
+
+// setParamValues overwrites every parameter input's value from
+// values, leaving focus where it is.
+func (m *FunctionSelectModel) setParamValues(values []string) {
+	for i := range m.inputs {
+		m.inputs[i].SetValue(values[i])
+	}
+}
+
+// pushUndo records values (the parameter state just before the edit
+// that's about to be applied) onto the undo stack, bounding it at
+// maxParamEditHistory, and clears the redo stack: a fresh edit
+// invalidates whatever could previously be redone.
+func (m *FunctionSelectModel) pushUndo(values []string) {
+	m.undoStack = append(m.undoStack, values)
+	if len(m.undoStack) > maxParamEditHistory {
+		m.undoStack = m.undoStack[len(m.undoStack)-maxParamEditHistory:]
+	}
+	m.redoStack = nil
+}
+
+// undo restores the most recently recorded parameter snapshot,
+// pushing the current values onto the redo stack first.
+func (m *FunctionSelectModel) undo() {
+	if len(m.undoStack) == 0 {
+		return
+	}
+	prev := m.undoStack[len(m.undoStack)-1]
+	m.undoStack = m.undoStack[:len(m.undoStack)-1]
+	m.redoStack = append(m.redoStack, m.paramValues())
+	m.setParamValues(prev)
+}
+
+// redo re-applies the most recently undone parameter snapshot,
+// pushing the current values back onto the undo stack first.
+func (m *FunctionSelectModel) redo() {
+	if len(m.redoStack) == 0 {
+		return
+	}
+	next := m.redoStack[len(m.redoStack)-1]
+	m.redoStack = m.redoStack[:len(m.redoStack)-1]
+	m.undoStack = append(m.undoStack, m.paramValues())
+	m.setParamValues(next)
+}
+
+func (m FunctionSelectModel) View() string {
+	if m.selected == nil {
+		return m.table.View() + "\npress enter to pick a function\n"
+	}
+
+	view := m.selected.Signature() + "\n"
+	for i, ti := range m.inputs {
+		view += fmt.Sprintf("%s: %s\n", m.selected.Inputs[i], ti.View())
+	}
+	switch {
+	case m.err != nil:
+		view += "error: " + m.err.Error() + "\n"
+	case m.calldata != nil:
+		view += fmt.Sprintf("calldata: 0x%x\n", m.calldata)
+	}
+	view += "esc to pick a different function\n"
+	return view
+}
@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"encoding/hex"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/abi"
+)
+
+func TestFunctionSelectModelEncodesCalldataFromEnteredArgs(t *testing.T) {
+	m := NewFunctionSelectModel([]abi.Function{
+		{Name: "transfer", Inputs: []string{"address", "uint256"}},
+	})
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	for _, r := range "0x00000000000000000000000000000000000000aa" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	for _, r := range "1000" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := model.(FunctionSelectModel).Calldata()
+	if got == nil {
+		t.Fatal("expected non-nil calldata after filling in both arguments")
+	}
+	want := "a9059cbb00000000000000000000000000000000000000000000000000000000000000aa" +
+		"00000000000000000000000000000000000000000000000000000000000003e8"
+	if gotHex := hex.EncodeToString(got); gotHex != want {
+		t.Errorf("got  %s\nwant %s", gotHex, want)
+	}
+}
+
+func TestFunctionSelectModelUndoRedoParamEdits(t *testing.T) {
+	m := NewFunctionSelectModel([]abi.Function{
+		{Name: "transfer", Inputs: []string{"address", "uint256"}},
+	})
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	for _, r := range "aa" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	for _, r := range "100" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	if got := model.(FunctionSelectModel).paramValues(); got[0] != "aa" || got[1] != "100" {
+		t.Fatalf("got params %v, want [aa 100]", got)
+	}
+
+	// Undo the last "0" of "100" twice, landing on "1".
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if got := model.(FunctionSelectModel).paramValues(); got[1] != "1" {
+		t.Errorf("got param[1] = %q after two undos, want %q", got[1], "1")
+	}
+
+	// Redo restores the "0" that the second undo removed.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if got := model.(FunctionSelectModel).paramValues(); got[1] != "10" {
+		t.Errorf("got param[1] = %q after redo, want %q", got[1], "10")
+	}
+
+	// A fresh edit after an undo discards the redo history.
+	for _, r := range "5" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if got := model.(FunctionSelectModel).paramValues(); got[1] != "105" {
+		t.Errorf("got param[1] = %q, want the new edit to survive a no-op redo", got[1])
+	}
+}
+
+func TestFunctionSelectModelUndoRedoAreBoundedAndResetPerFunction(t *testing.T) {
+	fns := []abi.Function{
+		{Name: "f", Inputs: []string{"uint256"}},
+	}
+	m := NewFunctionSelectModel(fns)
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	for i := 0; i < maxParamEditHistory+10; i++ {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	}
+	fsm := model.(FunctionSelectModel)
+	if len(fsm.undoStack) > maxParamEditHistory {
+		t.Errorf("got undo stack of %d entries, want at most %d", len(fsm.undoStack), maxParamEditHistory)
+	}
+
+	// Re-selecting a function resets the undo/redo history.
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	fsm = model.(FunctionSelectModel)
+	if len(fsm.undoStack) != 0 || len(fsm.redoStack) != 0 {
+		t.Errorf("got undo/redo stacks %v/%v after reselecting, want both empty", fsm.undoStack, fsm.redoStack)
+	}
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/history"
+	"github.com/evmts/chop/internal/render"
+)
+
+var historyListColumns = []table.Column{
+	{Title: "#", Width: 4},
+	{Title: "To", Width: 44},
+	{Title: "Status", Width: 8},
+	{Title: "Gas Used", Width: 10},
+}
+
+// HistoryListModel lists recorded calls from a history.Recorder.
+// Pressing "m" marks the highlighted entry; pressing "enter" while an
+// entry is marked and a different one is highlighted opens a diff view
+// comparing gas used, status, output, and logs between the two (see
+// history.Diff), with differing fields highlighted. "esc" closes the
+// diff view and returns to the list; pressing "m" again clears the
+// mark.
+type HistoryListModel struct {
+	table   table.Model
+	entries []history.Entry
+	marked  *history.Entry
+	diff    []history.DiffField
+}
+
+// NewHistoryListModel builds a HistoryListModel listing entries, oldest
+// first.
+func NewHistoryListModel(entries []history.Entry) HistoryListModel {
+	m := HistoryListModel{
+		entries: entries,
+		table:   table.New(table.WithColumns(historyListColumns)),
+	}
+	m.refreshRows()
+	return m
+}
+
+func (m *HistoryListModel) refreshRows() {
+	rows := make([]table.Row, len(m.entries))
+	for i, e := range m.entries {
+		to := "(create)"
+		if e.Call.To != nil {
+			to = fmt.Sprintf("0x%x", *e.Call.To)
+		}
+		status := "success"
+		if !e.Call.Status {
+			status = "failed"
+		}
+		rows[i] = table.Row{fmt.Sprintf("%d", e.ID), to, status, fmt.Sprintf("%d", e.Call.GasUsed)}
+	}
+	m.table.SetRows(rows)
+}
+
+// highlighted returns the Entry under the cursor, if any.
+func (m HistoryListModel) highlighted() (history.Entry, bool) {
+	idx := m.table.Cursor()
+	if idx < 0 || idx >= len(m.entries) {
+		return history.Entry{}, false
+	}
+	return m.entries[idx], true
+}
+
+func (m HistoryListModel) Init() tea.Cmd { return nil }
+
+func (m HistoryListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	if len(m.diff) > 0 {
+		if keyMsg.String() == "esc" {
+			m.diff = nil
+		}
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "m":
+		current, ok := m.highlighted()
+		if !ok {
+			return m, nil
+		}
+		if m.marked != nil && m.marked.ID == current.ID {
+			m.marked = nil
+			return m, nil
+		}
+		m.marked = &current
+		return m, nil
+	case "enter":
+		current, ok := m.highlighted()
+		if !ok || m.marked == nil || m.marked.ID == current.ID {
+			return m, nil
+		}
+		m.diff = history.Diff(*m.marked, current)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m HistoryListModel) View() string {
+	if len(m.diff) > 0 {
+		return "call diff\n" + render.RenderHistoryDiff(m.diff) + "\nesc to return to the list\n"
+	}
+
+	view := m.table.View()
+	if m.marked != nil {
+		view += fmt.Sprintf("\nmarked entry #%d; select another and press enter to diff\n", m.marked.ID)
+	} else {
+		view += "\nm to mark an entry for diffing\n"
+	}
+	return view
+}
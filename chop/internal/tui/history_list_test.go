@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/history"
+	"github.com/evmts/chop/internal/types"
+)
+
+func twoEntries() []history.Entry {
+	return []history.Entry{
+		{ID: 1, Timestamp: time.Unix(0, 0), Call: types.CallResult{GasUsed: 21000, Status: true}},
+		{ID: 2, Timestamp: time.Unix(1, 0), Call: types.CallResult{GasUsed: 23000, Status: false}},
+	}
+}
+
+func TestHistoryListMarkThenSelectOpensDiff(t *testing.T) {
+	m := NewHistoryListModel(twoEntries())
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := model.(HistoryListModel)
+	if len(got.diff) == 0 {
+		t.Fatal("want a diff after marking one entry and selecting another")
+	}
+	if !strings.Contains(got.View(), "*") {
+		t.Errorf("got view %q, want the differing fields marked", got.View())
+	}
+}
+
+func TestHistoryListEnterWithoutMarkIsNoOp(t *testing.T) {
+	m := NewHistoryListModel(twoEntries())
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := model.(HistoryListModel)
+	if len(got.diff) != 0 {
+		t.Error("want no diff when enter is pressed without a marked entry")
+	}
+}
+
+func TestHistoryListEscFromDiffReturnsToList(t *testing.T) {
+	m := NewHistoryListModel(twoEntries())
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	got := model.(HistoryListModel)
+	if len(got.diff) != 0 {
+		t.Error("want esc to clear the diff view")
+	}
+}
+
+func TestHistoryListMarkAgainClearsMark(t *testing.T) {
+	m := NewHistoryListModel(twoEntries())
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+
+	got := model.(HistoryListModel)
+	if got.marked != nil {
+		t.Error("want pressing m twice on the same entry to clear the mark")
+	}
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/evmts/chop/internal/bytecode"
+)
+
+var overlayStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+
+// OpcodeOverlayModel is a small floating panel showing the reference
+// entry for the opcode at the currently selected instruction in the
+// disassembly view.
+type OpcodeOverlayModel struct {
+	op   bytecode.Opcode
+	fork bytecode.Fork
+}
+
+// NewOpcodeOverlay builds an overlay for op, resolving gas/availability
+// against fork.
+func NewOpcodeOverlay(op bytecode.Opcode, fork bytecode.Fork) OpcodeOverlayModel {
+	return OpcodeOverlayModel{op: op, fork: fork}
+}
+
+func (m OpcodeOverlayModel) Init() tea.Cmd { return nil }
+
+func (m OpcodeOverlayModel) Update(tea.Msg) (tea.Model, tea.Cmd) { return m, nil }
+
+func (m OpcodeOverlayModel) View() string {
+	op := m.op
+	body := fmt.Sprintf(
+		"%s (0x%02x)\nstack: %d in, %d out\ngas (%s): %d\nsince: %s\n%s",
+		op.Name, op.Byte, op.StackIn, op.StackOut, m.fork, op.GasAt(m.fork), op.Since, op.Description,
+	)
+	return overlayStyle.Render(body)
+}
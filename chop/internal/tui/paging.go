@@ -0,0 +1,99 @@
+package tui
+
+// Paginator windows a slice of a fixed page size, so a table backed by
+// thousands of rows only ever has one page's worth materialized into
+// table.SetRows at a time. It tracks the current page and maps a
+// cursor position within the visible window back to an index in the
+// full underlying slice.
+type Paginator struct {
+	total    int
+	pageSize int
+	page     int
+}
+
+// NewPaginator returns a Paginator over total items, pageSize at a
+// time, starting on the first page. pageSize <= 0 is treated as 1.
+func NewPaginator(total, pageSize int) *Paginator {
+	if pageSize <= 0 {
+		pageSize = 1
+	}
+	p := &Paginator{total: total, pageSize: pageSize}
+	p.clampPage()
+	return p
+}
+
+// SetTotal updates the item count (e.g. after a refresh) and clamps
+// the current page so it still points at real rows.
+func (p *Paginator) SetTotal(total int) {
+	p.total = total
+	p.clampPage()
+}
+
+// PageCount returns the number of pages needed to cover total items;
+// always at least 1, even when there are no items, so an empty table
+// still has a valid current page.
+func (p *Paginator) PageCount() int {
+	if p.total == 0 {
+		return 1
+	}
+	return (p.total + p.pageSize - 1) / p.pageSize
+}
+
+// Page returns the current page number (0-based).
+func (p *Paginator) Page() int { return p.page }
+
+// NextPage advances to the next page, if any, and reports whether it moved.
+func (p *Paginator) NextPage() bool {
+	if p.page+1 >= p.PageCount() {
+		return false
+	}
+	p.page++
+	return true
+}
+
+// PrevPage moves back a page, if any, and reports whether it moved.
+func (p *Paginator) PrevPage() bool {
+	if p.page == 0 {
+		return false
+	}
+	p.page--
+	return true
+}
+
+// Bounds returns the [start, end) bounds of the current page within
+// the full underlying slice.
+func (p *Paginator) Bounds() (start, end int) {
+	start = p.page * p.pageSize
+	if start > p.total {
+		start = p.total
+	}
+	end = start + p.pageSize
+	if end > p.total {
+		end = p.total
+	}
+	return start, end
+}
+
+// Index maps a cursor position within the visible window (0-based, as
+// table.Model.Cursor() reports) to its index in the full underlying
+// slice. It returns -1 if cursor falls outside the current page.
+func (p *Paginator) Index(cursor int) int {
+	start, end := p.Bounds()
+	idx := start + cursor
+	if idx < start || idx >= end {
+		return -1
+	}
+	return idx
+}
+
+// clampPage pulls the current page back into [0, PageCount()) after
+// the item count changes, e.g. so a page that's now past the end
+// (last entry deleted) steps back to the new last page.
+func (p *Paginator) clampPage() {
+	if last := p.PageCount() - 1; p.page > last {
+		p.page = last
+	}
+	if p.page < 0 {
+		p.page = 0
+	}
+}
@@ -0,0 +1,81 @@
+package tui
+
+import "testing"
+
+func TestPaginatorIndexMapsWithinFirstPage(t *testing.T) {
+	p := NewPaginator(25, 10)
+	if idx := p.Index(0); idx != 0 {
+		t.Errorf("got index %d, want 0", idx)
+	}
+	if idx := p.Index(9); idx != 9 {
+		t.Errorf("got index %d, want 9", idx)
+	}
+}
+
+func TestPaginatorIndexOutOfBoundsOnCurrentPage(t *testing.T) {
+	p := NewPaginator(25, 10)
+	if idx := p.Index(10); idx != -1 {
+		t.Errorf("got index %d, want -1 (row 10 is on the next page)", idx)
+	}
+}
+
+func TestPaginatorNextPageShiftsIndexByPageSize(t *testing.T) {
+	p := NewPaginator(25, 10)
+	if !p.NextPage() {
+		t.Fatal("expected NextPage to succeed")
+	}
+	if idx := p.Index(0); idx != 10 {
+		t.Errorf("got index %d, want 10 (first row of second page)", idx)
+	}
+	if idx := p.Index(9); idx != 19 {
+		t.Errorf("got index %d, want 19 (last row of second page)", idx)
+	}
+}
+
+func TestPaginatorLastPageIsPartialAndBounded(t *testing.T) {
+	p := NewPaginator(25, 10)
+	p.NextPage()
+	p.NextPage()
+	if p.Page() != 2 {
+		t.Fatalf("got page %d, want 2", p.Page())
+	}
+	if idx := p.Index(4); idx != 24 {
+		t.Errorf("got index %d, want 24 (last item)", idx)
+	}
+	if idx := p.Index(5); idx != -1 {
+		t.Errorf("got index %d, want -1 (partial page only has 5 rows)", idx)
+	}
+	if p.NextPage() {
+		t.Error("expected NextPage to fail past the last page")
+	}
+}
+
+func TestPaginatorPrevPageAtFirstPageFails(t *testing.T) {
+	p := NewPaginator(25, 10)
+	if p.PrevPage() {
+		t.Error("expected PrevPage to fail on the first page")
+	}
+}
+
+func TestPaginatorSetTotalClampsCurrentPage(t *testing.T) {
+	p := NewPaginator(25, 10)
+	p.NextPage()
+	p.NextPage()
+	if p.Page() != 2 {
+		t.Fatalf("got page %d, want 2", p.Page())
+	}
+	p.SetTotal(5)
+	if p.Page() != 0 {
+		t.Errorf("got page %d, want 0 after shrinking below the old page's start", p.Page())
+	}
+}
+
+func TestPaginatorEmptyHasOnePage(t *testing.T) {
+	p := NewPaginator(0, 10)
+	if p.PageCount() != 1 {
+		t.Errorf("got %d pages, want 1 for an empty list", p.PageCount())
+	}
+	if idx := p.Index(0); idx != -1 {
+		t.Errorf("got index %d, want -1 for an empty page", idx)
+	}
+}
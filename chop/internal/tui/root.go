@@ -0,0 +1,205 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fork"
+)
+
+// rootTab identifies one of RootModel's top-level views, cycled with
+// "tab"/"shift+tab" or jumped to directly from the command palette.
+type rootTab int
+
+const (
+	tabContracts rootTab = iota
+	tabHistory
+	tabFixtures
+	tabSettings
+	tabChain
+)
+
+// rootTabNames gives each rootTab its display label and command
+// palette Target, in tab order.
+var rootTabNames = []string{"Contracts", "History", "Fixtures", "Settings", "Chain"}
+
+// rootCommands is the command palette's jump list for RootModel, one
+// "Go to X" entry per tab.
+func rootCommands() []Command {
+	commands := make([]Command, len(rootTabNames))
+	for i, name := range rootTabNames {
+		commands[i] = Command{Name: "Go to " + name, Target: name}
+	}
+	return commands
+}
+
+// rootTickInterval is how often RootModel refreshes the Contracts and
+// Settings tabs from the live chain, so activity from a concurrently
+// running `chop tui`-managed RPC server shows up without a keypress.
+const rootTickInterval = time.Second
+
+type tickMsg struct{}
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(rootTickInterval, func(time.Time) tea.Msg { return tickMsg{} })
+}
+
+// RootModel composes chop TUI's top-level views - Contracts, History,
+// Fixtures, Settings, and the chain selector - into a single tabbed
+// session backed by a shared *blockchain.Chain. "tab"/"shift+tab"
+// cycle tabs, "ctrl+p" opens a command palette to jump to one
+// directly, and "ctrl+c" quits. Contracts and Settings refresh
+// automatically every rootTickInterval; History has no live-refresh
+// hook of its own (see HistoryListModel), so "r" while on the History
+// tab reloads it from chain.History instead.
+type RootModel struct {
+	chain *blockchain.Chain
+
+	tab       rootTab
+	contracts ContractsModel
+	history   HistoryListModel
+	fixtures  FixturesListModel
+	settings  SettingsModel
+	chainSel  ChainSelectorModel
+
+	palette *CommandPaletteModel
+}
+
+// NewRootModel builds a RootModel over chain. forker and vmManager may
+// be nil, same as NewSettingsModel. chainID and decimals seed the
+// chain selector's initially active chain (see NewChainSelectorModel).
+func NewRootModel(chain *blockchain.Chain, forker *fork.Forker, vmManager *evm.VMManager, chainID uint64, decimals uint8) (RootModel, error) {
+	fixturesModel, err := NewFixturesListModel()
+	if err != nil {
+		return RootModel{}, err
+	}
+	return RootModel{
+		chain:     chain,
+		contracts: NewContractsModel(chain.Contracts),
+		history:   NewHistoryListModel(chain.History.All()),
+		fixtures:  fixturesModel,
+		settings:  NewSettingsModel(forker, chain, vmManager),
+		chainSel:  NewChainSelectorModel(chainID, decimals),
+	}, nil
+}
+
+func (m RootModel) Init() tea.Cmd { return tickCmd() }
+
+func (m RootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tickMsg); ok {
+		m.contracts.Refresh()
+		m.settings.Refresh()
+		return m, tickCmd()
+	}
+
+	if m.palette != nil {
+		return m.updatePalette(msg)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "ctrl+p":
+			palette := NewCommandPaletteModel(rootCommands(), rootTabNames[m.tab])
+			m.palette = &palette
+			return m, nil
+		case "tab":
+			m.tab = (m.tab + 1) % rootTab(len(rootTabNames))
+			return m, nil
+		case "shift+tab":
+			m.tab = (m.tab - 1 + rootTab(len(rootTabNames))) % rootTab(len(rootTabNames))
+			return m, nil
+		case "r":
+			if m.tab == tabHistory {
+				m.history = NewHistoryListModel(m.chain.History.All())
+				return m, nil
+			}
+		}
+	}
+
+	return m.updateActive(msg)
+}
+
+// updatePalette feeds msg to the open palette and, once it's been
+// dismissed (by a selection or "esc"), jumps to the selected tab (if
+// any) and closes it.
+func (m RootModel) updatePalette(msg tea.Msg) (tea.Model, tea.Cmd) {
+	updated, cmd := m.palette.Update(msg)
+	palette := updated.(CommandPaletteModel)
+	m.palette = &palette
+
+	switch {
+	case m.palette.Canceled():
+		m.palette = nil
+	case m.palette.Selected() != "":
+		for i, name := range rootTabNames {
+			if name == m.palette.Selected() {
+				m.tab = rootTab(i)
+			}
+		}
+		m.palette = nil
+	}
+	return m, cmd
+}
+
+func (m RootModel) updateActive(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch m.tab {
+	case tabContracts:
+		var updated tea.Model
+		updated, cmd = m.contracts.Update(msg)
+		m.contracts = updated.(ContractsModel)
+	case tabHistory:
+		var updated tea.Model
+		updated, cmd = m.history.Update(msg)
+		m.history = updated.(HistoryListModel)
+	case tabFixtures:
+		var updated tea.Model
+		updated, cmd = m.fixtures.Update(msg)
+		m.fixtures = updated.(FixturesListModel)
+	case tabSettings:
+		var updated tea.Model
+		updated, cmd = m.settings.Update(msg)
+		m.settings = updated.(SettingsModel)
+	case tabChain:
+		var updated tea.Model
+		updated, cmd = m.chainSel.Update(msg)
+		m.chainSel = updated.(ChainSelectorModel)
+	}
+	return m, cmd
+}
+
+func (m RootModel) View() string {
+	if m.palette != nil {
+		return m.palette.View()
+	}
+
+	header := ""
+	for i, name := range rootTabNames {
+		if rootTab(i) == m.tab {
+			header += "[" + name + "] "
+		} else {
+			header += " " + name + "  "
+		}
+	}
+	header += "\nctrl+p: commands  tab: next  shift+tab: prev  ctrl+c: quit\n\n"
+
+	var body string
+	switch m.tab {
+	case tabContracts:
+		body = m.contracts.View()
+	case tabHistory:
+		body = m.history.View()
+	case tabFixtures:
+		body = m.fixtures.View()
+	case tabSettings:
+		body = m.settings.View()
+	case tabChain:
+		body = m.chainSel.View()
+	}
+	return header + body
+}
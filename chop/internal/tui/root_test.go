@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/blockchain"
+)
+
+func newTestRootModel(t *testing.T) RootModel {
+	t.Helper()
+	m, err := NewRootModel(blockchain.New(), nil, nil, 1, 18)
+	if err != nil {
+		t.Fatalf("NewRootModel: %v", err)
+	}
+	return m
+}
+
+func TestRootModelTabCyclesForwardAndBack(t *testing.T) {
+	m := newTestRootModel(t)
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if got := model.(RootModel).tab; got != tabHistory {
+		t.Fatalf("got tab %v after one tab press, want tabHistory", got)
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if got := model.(RootModel).tab; got != tabContracts {
+		t.Fatalf("got tab %v after shift+tab, want tabContracts", got)
+	}
+}
+
+func TestRootModelPaletteJumpsToSelectedTab(t *testing.T) {
+	m := newTestRootModel(t)
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	if model.(RootModel).palette == nil {
+		t.Fatal("want ctrl+p to open the command palette")
+	}
+
+	for _, r := range "Settings" {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	got := model.(RootModel)
+	if got.palette != nil {
+		t.Error("want the palette to close after a selection")
+	}
+	if got.tab != tabSettings {
+		t.Errorf("got tab %v, want tabSettings", got.tab)
+	}
+}
+
+func TestRootModelViewShowsActiveTab(t *testing.T) {
+	m := newTestRootModel(t)
+
+	var model tea.Model = m
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	if view := model.(RootModel).View(); !strings.Contains(view, "[History]") {
+		t.Errorf("got view %q, want it to mark History as the active tab", view)
+	}
+}
@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/evmts/chop/internal/blockchain"
+	"github.com/evmts/chop/internal/evm"
+	"github.com/evmts/chop/internal/fork"
+)
+
+// defaultAutoMineInterval is the interval used when auto-mining is
+// toggled on from the TUI, which doesn't prompt for a custom interval.
+const defaultAutoMineInterval = 2 * time.Second
+
+// SettingsModel renders the "Settings" tab: the fork cache's
+// hit/miss/eviction/entry counters, the chain's auto-mine state, and
+// whether calls share persistent EVM state. Pressing "c" clears the
+// fork cache, "m" toggles auto-mine, "p" toggles persistent state, and
+// "x" clears any accumulated persistent state.
+type SettingsModel struct {
+	forker    *fork.Forker
+	chain     *blockchain.Chain
+	vmManager *evm.VMManager
+	table     table.Model
+}
+
+// NewSettingsModel builds a SettingsModel backed by forker, chain, and
+// vmManager. forker may be nil if the session isn't forking a remote
+// chain, in which case the view reports that forking is disabled.
+// vmManager may be nil if the session doesn't support persistent state.
+func NewSettingsModel(forker *fork.Forker, chain *blockchain.Chain, vmManager *evm.VMManager) SettingsModel {
+	columns := []table.Column{
+		{Title: "Metric", Width: 16},
+		{Title: "Value", Width: 10},
+	}
+	m := SettingsModel{forker: forker, chain: chain, vmManager: vmManager, table: table.New(table.WithColumns(columns))}
+	m.Refresh()
+	return m
+}
+
+// Refresh recomputes the table rows from the Forker's current stats,
+// the chain's auto-mine state, and whether persistent state is on.
+func (m *SettingsModel) Refresh() {
+	var rows []table.Row
+	if m.forker != nil {
+		stats := m.forker.Stats()
+		rows = append(rows,
+			table.Row{"Hits", fmt.Sprintf("%d", stats.Hits)},
+			table.Row{"Misses", fmt.Sprintf("%d", stats.Misses)},
+			table.Row{"Evictions", fmt.Sprintf("%d", stats.Evictions)},
+			table.Row{"Entries", fmt.Sprintf("%d", stats.Entries)},
+		)
+	}
+	if m.chain != nil {
+		state := "off"
+		if m.chain.AutoMining() {
+			state = "on"
+		}
+		rows = append(rows, table.Row{"Auto-mine", state})
+	}
+	if m.vmManager != nil {
+		state := "off"
+		if m.vmManager.Persistent() {
+			state = "on"
+		}
+		rows = append(rows, table.Row{"Persistent state", state})
+	}
+	m.table.SetRows(rows)
+}
+
+func (m SettingsModel) Init() tea.Cmd { return nil }
+
+func (m SettingsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.table, cmd = m.table.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "c":
+		if m.forker != nil {
+			m.forker.ClearCache()
+			m.Refresh()
+			return m, nil
+		}
+	case "m":
+		if m.chain != nil {
+			if m.chain.AutoMining() {
+				m.chain.StopAutoMine()
+			} else {
+				m.chain.StartAutoMine(defaultAutoMineInterval)
+			}
+			m.Refresh()
+			return m, nil
+		}
+	case "p":
+		if m.vmManager != nil {
+			m.vmManager.SetPersistent(!m.vmManager.Persistent())
+			m.Refresh()
+			return m, nil
+		}
+	case "x":
+		if m.vmManager != nil {
+			m.vmManager.ClearState()
+			m.Refresh()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m SettingsModel) View() string {
+	if m.forker == nil && m.chain == nil && m.vmManager == nil {
+		return "forking is disabled for this session\n"
+	}
+	view := m.table.View() + "\n"
+	if m.forker != nil {
+		view += "press c to clear the fork cache\n"
+	} else {
+		view += "forking is disabled for this session\n"
+	}
+	if m.chain != nil {
+		view += "press m to toggle auto-mine\n"
+	}
+	if m.vmManager != nil {
+		view += "press p to toggle persistent state, x to clear it\n"
+	}
+	return view
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// errorStringSelector is Error(string)'s 4-byte selector, what solc
+// emits for a plain `revert("message")`.
+var errorStringSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// panicSelector is Panic(uint256)'s 4-byte selector, what solc emits
+// for assert failures, arithmetic overflow, out-of-bounds access, etc.
+var panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+// DecodeRevert parses r.Output as standard Solidity revert data —
+// Error(string) or Panic(uint256) — populating RevertReason or
+// PanicCode accordingly. A successful call, a custom (non-standard)
+// error, or malformed/empty revert data is left alone; DecodeRevert
+// never errors, since a failure to decode the reason doesn't make the
+// call's gas/output/status any less meaningful.
+func (r CallResult) DecodeRevert() CallResult {
+	if r.Status || len(r.Output) < 4 {
+		return r
+	}
+	selector, data := r.Output[:4], r.Output[4:]
+
+	switch {
+	case bytes.Equal(selector, errorStringSelector):
+		if reason, ok := decodeErrorString(data); ok {
+			r.RevertReason = reason
+		}
+	case bytes.Equal(selector, panicSelector):
+		if len(data) >= 32 {
+			if code, ok := decodeUint256AsUint64(data[:32]); ok {
+				r.PanicCode = &code
+			}
+		}
+	}
+	return r
+}
+
+// decodeErrorString decodes Error(string)'s ABI-encoded argument: a
+// 32-byte offset, a 32-byte length at that offset, then the string
+// bytes.
+func decodeErrorString(data []byte) (string, bool) {
+	if len(data) < 64 {
+		return "", false
+	}
+	offset, ok := decodeUint256AsUint64(data[:32])
+	if !ok || offset > uint64(len(data))-32 {
+		return "", false
+	}
+	length, ok := decodeUint256AsUint64(data[offset : offset+32])
+	if !ok {
+		return "", false
+	}
+	start := offset + 32
+	end := start + length
+	if end > uint64(len(data)) {
+		return "", false
+	}
+	return string(data[start:end]), true
+}
+
+// decodeUint256AsUint64 reads a 32-byte big-endian ABI word as a
+// uint64, rejecting words whose value doesn't fit — which for
+// revert-data offsets/lengths/panic codes always indicates malformed
+// or adversarial input rather than a legitimately huge value.
+func decodeUint256AsUint64(word []byte) (uint64, bool) {
+	for _, b := range word[:24] {
+		if b != 0 {
+			return 0, false
+		}
+	}
+	return binary.BigEndian.Uint64(word[24:32]), true
+}
@@ -0,0 +1,93 @@
+package types
+
+import "testing"
+
+// abiWord left-pads n into a 32-byte big-endian ABI word.
+func abiWord(n uint64) []byte {
+	word := make([]byte, 32)
+	word[24] = byte(n >> 56)
+	word[25] = byte(n >> 48)
+	word[26] = byte(n >> 40)
+	word[27] = byte(n >> 32)
+	word[28] = byte(n >> 24)
+	word[29] = byte(n >> 16)
+	word[30] = byte(n >> 8)
+	word[31] = byte(n)
+	return word
+}
+
+// encodeErrorRevert builds a standard `revert("msg")` payload: selector,
+// offset, length, then the message padded to a 32-byte boundary.
+func encodeErrorRevert(msg string) []byte {
+	out := append([]byte{}, errorStringSelector...)
+	out = append(out, abiWord(32)...)
+	out = append(out, abiWord(uint64(len(msg)))...)
+	padded := make([]byte, (len(msg)+31)/32*32)
+	copy(padded, msg)
+	out = append(out, padded...)
+	return out
+}
+
+// encodePanicRevert builds a standard Panic(uint256) payload: selector
+// followed by the 32-byte panic code.
+func encodePanicRevert(code uint64) []byte {
+	out := append([]byte{}, panicSelector...)
+	out = append(out, abiWord(code)...)
+	return out
+}
+
+func TestDecodeRevertDecodesErrorString(t *testing.T) {
+	r := CallResult{Status: false, Output: encodeErrorRevert("insufficient balance")}
+	decoded := r.DecodeRevert()
+	if decoded.RevertReason != "insufficient balance" {
+		t.Errorf("got RevertReason %q, want %q", decoded.RevertReason, "insufficient balance")
+	}
+	if decoded.PanicCode != nil {
+		t.Errorf("got PanicCode %v, want nil", decoded.PanicCode)
+	}
+}
+
+func TestDecodeRevertDecodesPanicCode(t *testing.T) {
+	r := CallResult{Status: false, Output: encodePanicRevert(0x11)}
+	decoded := r.DecodeRevert()
+	if decoded.PanicCode == nil || *decoded.PanicCode != 0x11 {
+		t.Errorf("got PanicCode %v, want 0x11", decoded.PanicCode)
+	}
+	if decoded.RevertReason != "" {
+		t.Errorf("got RevertReason %q, want empty", decoded.RevertReason)
+	}
+}
+
+func TestDecodeRevertIgnoresSuccessfulCalls(t *testing.T) {
+	r := CallResult{Status: true, Output: encodeErrorRevert("shouldn't matter")}
+	decoded := r.DecodeRevert()
+	if decoded.RevertReason != "" || decoded.PanicCode != nil {
+		t.Errorf("expected a successful call to be left alone, got %+v", decoded)
+	}
+}
+
+func TestDecodeRevertHandlesMalformedDataGracefully(t *testing.T) {
+	cases := [][]byte{
+		nil,
+		{},
+		{0x08, 0xc3},
+		append([]byte{}, errorStringSelector...),
+		append(append([]byte{}, errorStringSelector...), abiWord(0xffffffff)...),
+		append([]byte{}, panicSelector...),
+	}
+	for i, data := range cases {
+		r := CallResult{Status: false, Output: data}
+		decoded := r.DecodeRevert()
+		if decoded.RevertReason != "" || decoded.PanicCode != nil {
+			t.Errorf("case %d: expected malformed data to decode to nothing, got %+v", i, decoded)
+		}
+	}
+}
+
+func TestDecodeRevertIgnoresCustomErrors(t *testing.T) {
+	r := CallResult{Status: false, Output: []byte{0xde, 0xad, 0xbe, 0xef, 0x01, 0x02}}
+	decoded := r.DecodeRevert()
+	if decoded.RevertReason != "" || decoded.PanicCode != nil {
+		t.Errorf("expected a custom error selector to be left alone, got %+v", decoded)
+	}
+}
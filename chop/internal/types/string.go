@@ -0,0 +1,23 @@
+package types
+
+import "fmt"
+
+// String renders a human-oriented one-line summary, used by `chop
+// call`'s default text output.
+func (r CallResult) String() string {
+	status := "success"
+	if !r.Status {
+		status = "failure"
+	}
+	s := fmt.Sprintf("status=%s gasUsed=%d/%d output=0x%x", status, r.GasUsed, r.GasLimit, r.Output)
+	if r.Error != "" {
+		s += fmt.Sprintf(" error=%s", r.Error)
+	}
+	if r.RevertReason != "" {
+		s += fmt.Sprintf(" revertReason=%q", r.RevertReason)
+	}
+	if r.PanicCode != nil {
+		s += fmt.Sprintf(" panicCode=0x%x", *r.PanicCode)
+	}
+	return s
+}
@@ -0,0 +1,154 @@
+// Package types holds the data shapes shared across chop's command,
+// server, and TUI layers so none of them need to import each other.
+package types
+
+// Address is a 20-byte Ethereum account address.
+type Address [20]byte
+
+// Hash is a 32-byte Keccak256 hash.
+type Hash [32]byte
+
+// Log is a single event emitted during a call.
+type Log struct {
+	Address Address `json:"address"`
+	Topics  []Hash  `json:"topics"`
+	Data    []byte  `json:"data"`
+}
+
+// BlockContext pins the block environment a call executes against.
+type BlockContext struct {
+	ChainID     uint64  `json:"chainId"`
+	BlockNumber uint64  `json:"blockNumber"`
+	Timestamp   uint64  `json:"timestamp"`
+	BaseFee     uint64  `json:"baseFee"`
+	Coinbase    Address `json:"coinbase"`
+	PrevRandao  Hash    `json:"prevRandao"`
+}
+
+// StorageSlot is a single key/value pair in an account's storage.
+type StorageSlot struct {
+	Key   Hash `json:"key"`
+	Value Hash `json:"value"`
+}
+
+// AccountState is a pre-funded account's balance, nonce, and code,
+// used to seed state before a call executes.
+type AccountState struct {
+	Balance uint64        `json:"balance"`
+	Nonce   uint64        `json:"nonce"`
+	Code    []byte        `json:"code,omitempty"`
+	Storage []StorageSlot `json:"storage,omitempty"`
+}
+
+// PreStateEntry pairs an address with the AccountState it should be
+// seeded with before a call executes.
+type PreStateEntry struct {
+	Address Address      `json:"address"`
+	Account AccountState `json:"account"`
+}
+
+// AccessListEntry pre-warms an address and a set of its storage slots
+// (EIP-2930), so SLOAD/account access within a call is charged the
+// cheaper warm gas cost instead of the cold one.
+type AccessListEntry struct {
+	Address     Address `json:"address"`
+	StorageKeys []Hash  `json:"storageKeys"`
+}
+
+// Transaction is a mined value transfer or contract call, as recorded
+// in a Block.
+type Transaction struct {
+	Hash        Hash     `json:"hash"`
+	From        Address  `json:"from"`
+	To          *Address `json:"to"` // nil for contract creation
+	Value       uint64   `json:"value"`
+	Data        []byte   `json:"data"`
+	GasLimit    uint64   `json:"gasLimit"`
+	GasUsed     uint64   `json:"gasUsed"`
+	Status      bool     `json:"status"`
+	Logs        []Log    `json:"logs,omitempty"`
+	BlockNumber uint64   `json:"blockNumber"`
+	// DeployedAddress is the address a CREATE transaction deployed to,
+	// echoed from CallResult.DeployedAddress. Nil for everything else.
+	DeployedAddress *Address `json:"deployedAddress,omitempty"`
+}
+
+// ReceiptLog is a Log annotated with its index among all logs emitted
+// in the log's block, as returned in a Receipt.
+type ReceiptLog struct {
+	Log
+	Index uint64 `json:"logIndex"`
+}
+
+// Receipt summarizes a mined transaction's outcome: its status, gas
+// accounting, and logs, as returned by eth_getTransactionReceipt.
+type Receipt struct {
+	TransactionHash   Hash         `json:"transactionHash"`
+	BlockNumber       uint64       `json:"blockNumber"`
+	From              Address      `json:"from"`
+	To                *Address     `json:"to"` // nil for contract creation
+	Status            bool         `json:"status"`
+	GasUsed           uint64       `json:"gasUsed"`
+	CumulativeGasUsed uint64       `json:"cumulativeGasUsed"`
+	Logs              []ReceiptLog `json:"logs"`
+	// ContractAddress is the address deployed by a CREATE transaction,
+	// nil for everything else.
+	ContractAddress *Address `json:"contractAddress,omitempty"`
+}
+
+// CallResult is the outcome of executing a single EVM call, whether
+// issued via `chop call`, the RPC server, or the TUI.
+type CallResult struct {
+	From            Address  `json:"from"`
+	To              *Address `json:"to"` // nil for contract creation
+	Input           []byte   `json:"input"`
+	Output          []byte   `json:"output"`
+	Value           uint64   `json:"value,omitempty"` // wei sent with the call, echoed back from the request
+	GasLimit        uint64   `json:"gasLimit"`
+	GasUsed         uint64   `json:"gasUsed"`
+	Status          bool     `json:"status"`
+	Logs            []Log    `json:"logs"`
+	DeployedAddress *Address `json:"deployedAddress,omitempty"` // set for contract creation
+	Error           string   `json:"error,omitempty"`
+
+	// PostState holds every account the call touched, balance/nonce/
+	// storage as of right after execution. Only populated when the
+	// call requested it (see evm.ExecutionContext.EmitPostState);
+	// evm.VMManager uses it to carry storage and balances forward into
+	// the next call when persistent state is enabled.
+	PostState []PreStateEntry `json:"postState,omitempty"`
+
+	// RevertReason is the decoded message from a standard Solidity
+	// Error(string) revert (e.g. `revert("insufficient balance")`).
+	// Empty unless the call failed and Output held a decodable
+	// Error(string) payload; see DecodeRevert.
+	RevertReason string `json:"revertReason,omitempty"`
+	// PanicCode is the decoded argument of a standard Solidity
+	// Panic(uint256) revert (e.g. 0x11 for arithmetic overflow). Nil
+	// unless the call failed and Output held a decodable Panic(uint256)
+	// payload; see DecodeRevert.
+	PanicCode *uint64 `json:"panicCode,omitempty"`
+
+	// FinalStack holds the top of the EVM stack (as 0x-prefixed hex
+	// words, topmost item first) at the moment execution stopped,
+	// including on REVERT. FinalMemory holds the full memory contents
+	// at that same moment. Both are only populated when the call
+	// requested it; see evm.ExecutionContext.CaptureState.
+	FinalStack  []string `json:"finalStack,omitempty"`
+	FinalMemory []byte   `json:"finalMemory,omitempty"`
+
+	// TransientStorage holds every slot written via TSTORE during the
+	// call, as 0x-prefixed hex slot -> value pairs, captured before
+	// Cancun wipes it at the end of the transaction. Only populated
+	// when the call requested it; see evm.ExecutionContext.CaptureState.
+	TransientStorage map[string]string `json:"transientStorage,omitempty"`
+
+	// EffectiveGasPrice is the base fee plus PriorityFeePaid: what the
+	// call actually paid per unit of gas under EIP-1559. PriorityFeePaid
+	// is the tip that went to the block producer, capped at
+	// MaxPriorityFeePerGas and at whatever headroom MaxFeePerGas leaves
+	// above the base fee. Both are zero unless the call set
+	// evm.ExecutionContext.MaxFeePerGas/MaxPriorityFeePerGas.
+	EffectiveGasPrice uint64 `json:"effectiveGasPrice,omitempty"`
+	PriorityFeePaid   uint64 `json:"priorityFeePaid,omitempty"`
+}